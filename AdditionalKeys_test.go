@@ -0,0 +1,64 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"testing"
+)
+
+func additionalKeysSchema(policy string) map[string]interface{} {
+	return map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "user.name", "type": "string"},
+			map[string]interface{}{"target_key": "user.tags.*", "type": "string"},
+		},
+		"additional_keys": map[string]interface{}{
+			"policy":    policy,
+			"overrides": map[string]interface{}{"metadata.*": "allow"},
+		},
+	}
+}
+
+func TestV2AdditionalKeys(t *testing.T) {
+	data := map[string]interface{}{
+		"user": map[string]interface{}{
+			"name":     "Amira",
+			"tags":     []interface{}{"admin"},
+			"password": "secret",
+		},
+		"metadata": map[string]interface{}{"source": map[string]interface{}{"ip": "10.0.0.1"}},
+	}
+
+	schematics, err := v2.LoadMap(additionalKeysSchema("reject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := schematics.Validate(data)
+	if len(errs.Messages) != 1 {
+		t.Errorf("expected a single error, got: %v", errs.Messages)
+	}
+	if e, exists := errs.Messages[errorHandler.Target("user.password")]; !exists || e.Validator != "additional-keys" {
+		t.Errorf("expected user.password to be rejected, got: %v", errs.Messages)
+	}
+
+	schematics, err = v2.LoadMap(additionalKeysSchema("strip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := schematics.Validate(data); errs.HasErrors() {
+		t.Errorf("strip should not report errors, got: %v", errs.Messages)
+	}
+	result, _ := schematics.Operate(data)
+	operated := *result.(*map[string]interface{})
+	if _, exists := operated["user"].(map[string]interface{})["password"]; exists {
+		t.Error("expected user.password to be stripped")
+	}
+	if _, exists := operated["metadata"]; !exists {
+		t.Error("metadata should be kept by the override")
+	}
+
+	if _, err := v2.LoadMap(additionalKeysSchema("deny")); err == nil {
+		t.Error("expected an unknown policy to fail on load")
+	}
+}
@@ -0,0 +1,106 @@
+package jsonschematics
+
+import (
+	"errors"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"testing"
+)
+
+func TestV2Aggregates(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "order.items.*.qty",
+				"type":       "integer",
+				"aggregates": []interface{}{
+					map[string]interface{}{"name": "Sum", "attributes": map[string]interface{}{"operator": "lte", "value": 100}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "contacts.*.primary",
+				"aggregates": []interface{}{map[string]interface{}{"name": "Any"}},
+			},
+			map[string]interface{}{
+				"target_key": "scores.*",
+				"aggregates": []interface{}{
+					map[string]interface{}{"name": "Avg", "attributes": map[string]interface{}{"operator": "gte", "value": 50}},
+					map[string]interface{}{"name": "Count", "attributes": map[string]interface{}{"operator": "gte", "value": 2}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"order":    map[string]interface{}{"items": []interface{}{map[string]interface{}{"qty": 60}, map[string]interface{}{"qty": 40}}},
+		"contacts": []interface{}{map[string]interface{}{"primary": false}, map[string]interface{}{"primary": true}},
+		"scores":   []interface{}{40, 70},
+	}); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"order":    map[string]interface{}{"items": []interface{}{map[string]interface{}{"qty": 60}, map[string]interface{}{"qty": 41}}},
+		"contacts": []interface{}{map[string]interface{}{"primary": false}},
+		"scores":   []interface{}{30, 60},
+	})
+	expected := map[string]string{
+		"order.items.*.qty":  "sum of the values is 101, expected lte 100",
+		"contacts.*.primary": "none of the values match",
+		"scores.*":           "avg of the values is 45, expected gte 50",
+	}
+	if len(errs.Messages) != len(expected) {
+		t.Errorf("expected %d errors, got: %v", len(expected), errs.Messages)
+	}
+	for target, message := range expected {
+		if e, exists := errs.Messages[errorHandler.Target(target)]; !exists || e.Message["en"] != message {
+			t.Errorf("expected %q for %s, got: %v", message, target, errs.Messages)
+		}
+	}
+
+	errs = schematics.Validate(map[string]interface{}{"contacts": []interface{}{map[string]interface{}{"primary": true}}})
+	if e, exists := errs.Messages["scores.*"]; !exists || e.Validator != "Count" {
+		t.Errorf("expected the count to be checked without matches, got: %v", errs.Messages)
+	}
+
+	for _, aggregate := range []map[string]interface{}{
+		{"name": "Sum"},
+		{"name": "Max", "attributes": map[string]interface{}{"operator": "bigger", "value": 10}},
+		{"name": "Any", "attributes": map[string]interface{}{"operator": "in", "value": "a"}},
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{
+			"version": "2",
+			"fields":  []interface{}{map[string]interface{}{"target_key": "scores.*", "aggregates": []interface{}{aggregate}}},
+		}); err == nil {
+			t.Errorf("expected %v to fail loading", aggregate)
+		}
+	}
+
+	custom, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{map[string]interface{}{
+			"target_key": "scores.*",
+			"aggregates": []interface{}{map[string]interface{}{"name": "Median"}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := map[string]interface{}{"scores": []interface{}{1, 2, 3}}
+	if errs := custom.Validate(data); errs == nil || errs.Messages["scores.*"].Message["en"] != "aggregate validator not registered" {
+		t.Errorf("expected the unknown aggregate to be reported, got: %v", errs)
+	}
+	custom.Validators.RegisterAggregateValidator("Median", func(values []interface{}, _ map[string]interface{}) error {
+		if len(values) != 3 {
+			return errors.New("expected three values")
+		}
+		return nil
+	})
+	if errs := custom.Validate(data); errs != nil {
+		t.Errorf("expected the custom aggregate to pass, got: %v", errs.Messages)
+	}
+}
@@ -0,0 +1,84 @@
+package jsonschematics
+
+import (
+	"encoding/json"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/validators"
+	"reflect"
+	"testing"
+)
+
+func TestV2TypeCoercion(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "query.limit",
+				"type":       "integer",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "MaxAllowed", "attributes": map[string]interface{}{"max": 100}},
+				},
+			},
+			map[string]interface{}{"target_key": "query.active", "type": "boolean"},
+			map[string]interface{}{"target_key": "query.from", "type": "date"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schematics.CoerceTypes = true
+
+	data := map[string]interface{}{
+		"query": map[string]interface{}{"limit": "42", "active": "true", "from": "2024-01-01"},
+	}
+	if errs := schematics.Validate(data); errs.HasErrors() {
+		t.Errorf("expected the strings to be coerced, got: %v", errs.Messages)
+	}
+	result, errs := schematics.Operate(data)
+	if errs.HasErrors() {
+		t.Fatal(errs.Messages)
+	}
+	query := (*result.(*map[string]interface{}))["query"].(map[string]interface{})
+	if query["limit"] != json.Number("42") || query["active"] != true || query["from"] != "2024-01-01" {
+		t.Errorf("expected coerced values, got: %v", query)
+	}
+
+	errs = schematics.Validate(map[string]interface{}{
+		"query": map[string]interface{}{"limit": "forty-two"},
+	})
+	if e, exists := errs.Messages["query.limit"]; !exists || e.Validator != "coerce" {
+		t.Errorf("expected a coerce error, got: %v", errs.Messages)
+	}
+	if _, errs := schematics.Operate(map[string]interface{}{
+		"query": map[string]interface{}{"active": "maybe"},
+	}); !errs.HasErrors() {
+		t.Error("expected Operate to report the failed conversion")
+	}
+}
+
+func TestCoerceNumbersExactly(t *testing.T) {
+	for _, test := range []struct {
+		typ      string
+		value    interface{}
+		expected interface{}
+	}{
+		{"integer", "9007199254740993", json.Number("9007199254740993")},
+		{"integer", "+42", json.Number("42")},
+		{"integer", "1e3", json.Number("1000")},
+		{"integer", 42.0, json.Number("42")},
+		{"number", "0.1", json.Number("0.1")},
+		{"number", "123456789012345678901234567890.5", json.Number("123456789012345678901234567890.5")},
+		{"string", json.Number("12345678901234567890"), "12345678901234567890"},
+		{"array", `[1.10, 2]`, []interface{}{json.Number("1.10"), json.Number("2")}},
+	} {
+		converted, err := validators.Coerce(test.typ, test.value)
+		if err != nil || !reflect.DeepEqual(converted, test.expected) {
+			t.Errorf("expected %v as %s to be %#v, got: %#v, %v", test.value, test.typ, test.expected, converted, err)
+		}
+	}
+	for _, value := range []interface{}{"12345678901234567890", "-9223372036854775809", 1e30, "42.5", "+-1", "NaN"} {
+		if converted, err := validators.Coerce("integer", value); err == nil {
+			t.Errorf("expected %v to fail as an integer, got: %#v", value, converted)
+		}
+	}
+}
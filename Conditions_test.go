@@ -0,0 +1,50 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"testing"
+)
+
+func TestV2WhenCondition(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "payment.card.number",
+				"required":   true,
+				"when": map[string]interface{}{
+					"target_key": "payment.method",
+					"operator":   "equals",
+					"value":      "card",
+				},
+				"validators": []interface{}{
+					map[string]interface{}{"name": "IsString"},
+				},
+				"else": []interface{}{
+					map[string]interface{}{"name": "MaxLengthAllowed", "attributes": map[string]interface{}{"max": 0}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"payment": map[string]interface{}{"method": "cash"},
+	}); errs.HasErrors() {
+		t.Errorf("required should not apply when the condition does not hold: %v", errs.Messages)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"payment": map[string]interface{}{"method": "card"},
+	}); !errs.HasErrors() {
+		t.Error("required should apply when the condition holds")
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"payment": map[string]interface{}{"method": "cash", "card": map[string]interface{}{"number": "4111"}},
+	}); !errs.HasErrors() {
+		t.Error("else validators should run when the condition does not hold")
+	}
+}
@@ -0,0 +1,150 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"reflect"
+	"testing"
+)
+
+func TestV2ValidatorsOnContainers(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "items",
+				"required":   true,
+				"type":       "array",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "ArrayLengthMin", "attributes": map[string]interface{}{"min": 2}},
+					map[string]interface{}{"name": "UniqueItems", "attributes": map[string]interface{}{"key": "sku"}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "items.*",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "RequiredKeys", "attributes": map[string]interface{}{"keys": []interface{}{"sku", "qty"}}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "tags",
+				"validators": []interface{}{map[string]interface{}{"name": "ArrayNotEmpty"}},
+			},
+			map[string]interface{}{
+				"target_key": "meta",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "MaxProperties", "attributes": map[string]interface{}{"max": 1}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "a", "qty": 1},
+			map[string]interface{}{"sku": "b", "qty": 2},
+		},
+		"tags": []interface{}{"x"},
+		"meta": map[string]interface{}{"source": "web"},
+	}); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "a", "qty": 1},
+			map[string]interface{}{"sku": "a"},
+		},
+		"tags": []interface{}{},
+		"meta": map[string]interface{}{"source": "web", "agent": "cli"},
+	})
+	expected := map[string]string{
+		"items":   "UniqueItems",
+		"items.1": "RequiredKeys",
+		"tags":    "ArrayNotEmpty",
+		"meta":    "MaxProperties",
+	}
+	if len(errs.Messages) != len(expected) {
+		t.Errorf("expected %d errors, got: %v", len(expected), errs.Messages)
+	}
+	for target, validator := range expected {
+		if e, exists := errs.Messages[errorHandler.Target(target)]; !exists || e.Validator != validator {
+			t.Errorf("expected a %s error for %s, got: %v", validator, target, errs.Messages)
+		}
+	}
+
+	errs = schematics.Validate(map[string]interface{}{"items": []interface{}{}})
+	if e, exists := errs.Messages["items"]; !exists || e.Validator != "ArrayLengthMin" {
+		t.Errorf("expected the empty array to be validated, got: %v", errs.Messages)
+	}
+}
+
+func TestV2OperatorsOnContainers(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "users",
+				"operators": []interface{}{
+					map[string]interface{}{"name": "ArrayOfObjToObj", "attributes": map[string]interface{}{"unique_string_key": "id"}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "users.*.name",
+				"operators":  []interface{}{map[string]interface{}{"name": "UpperCase"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, errs := schematics.Operate(map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{"id": "u1", "name": "ada"},
+			map[string]interface{}{"id": "u2", "name": "alan"},
+		},
+		"tags": []interface{}{"a", "b"},
+	})
+	if errs != nil {
+		t.Fatalf("expected no errors, got: %v", errs.Messages)
+	}
+	expected := map[string]interface{}{
+		"users": map[string]interface{}{
+			"u1": map[string]interface{}{"id": "u1", "name": "ADA"},
+			"u2": map[string]interface{}{"id": "u2", "name": "ALAN"},
+		},
+		"tags": []interface{}{"a", "b"},
+	}
+	if got := *result.(*map[string]interface{}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got: %v", expected, got)
+	}
+}
+
+func TestV2ContainersSeeDefaultsAndCoercion(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "profile.country", "default": "US"},
+			map[string]interface{}{"target_key": "profile.age", "type": "integer"},
+			map[string]interface{}{
+				"target_key": "profile",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "RequiredKeys", "attributes": map[string]interface{}{"keys": []interface{}{"country"}}},
+					map[string]interface{}{"name": "Expr", "attributes": map[string]interface{}{"expression": "value.age >= 18"}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schematics.DefaultsBeforeValidation = true
+	schematics.CoerceTypes = true
+	if errs := schematics.Validate(map[string]interface{}{"profile": map[string]interface{}{"age": "21"}}); errs != nil {
+		t.Errorf("expected the container to get the default and the coerced age, got: %v", errs.Messages)
+	}
+}
@@ -0,0 +1,76 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"reflect"
+	"testing"
+)
+
+func operatorField(target string, name string, attributes map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"target_key": target,
+		"operators":  []interface{}{map[string]interface{}{"name": name, "attributes": attributes}},
+	}
+}
+
+func TestV2DateOperators(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			operatorField("born", "FormatDate", map[string]interface{}{"layout": "02.01.2006", "timezone": "Europe/Berlin"}),
+			operatorField("meeting", "ConvertTimezone", map[string]interface{}{"to": "America/New_York"}),
+			operatorField("month", "TruncateDate", map[string]interface{}{"unit": "month", "output_layout": "date"}),
+			operatorField("expires", "AddToDate", map[string]interface{}{"duration": "30d", "layout": "date", "output_layout": "date"}),
+			operatorField("reminder", "SubtractFromDate", map[string]interface{}{"duration": "1h30m"}),
+			operatorField("epoch", "ToUnix", map[string]interface{}{"unit": "ms"}),
+			operatorField("seen", "FromUnix", nil),
+			operatorField("broken", "FormatDate", map[string]interface{}{"layout": "date"}),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, errs := schematics.Operate(map[string]interface{}{
+		"born":     "31.12.1990",
+		"meeting":  "2024-06-15T14:00:00Z",
+		"month":    "2024-06-15T14:00:00Z",
+		"expires":  "2024-01-15",
+		"reminder": "2024-06-15T09:00:00+02:00",
+		"epoch":    "2024-06-15T00:00:00Z",
+		"seen":     1718409600,
+		"broken":   "not a date",
+	})
+	expected := map[string]interface{}{
+		"born":     "1990-12-31T00:00:00+01:00",
+		"meeting":  "2024-06-15T10:00:00-04:00",
+		"month":    "2024-06-01",
+		"expires":  "2024-02-14",
+		"reminder": "2024-06-15T07:30:00+02:00",
+		"epoch":    int64(1718409600000),
+		"seen":     "2024-06-15T00:00:00Z",
+		"broken":   "not a date",
+	}
+	if operated := *result.(*map[string]interface{}); !reflect.DeepEqual(operated, expected) {
+		t.Errorf("expected %v, got: %v", expected, operated)
+	}
+	if errs == nil || len(errs.Messages) != 1 || errs.Messages["broken"].Validator != "FormatDate" {
+		t.Errorf("expected the FormatDate error of broken, got: %v", errs)
+	}
+
+	for _, field := range []map[string]interface{}{
+		operatorField("a", "FormatDate", map[string]interface{}{"timezone": "Mars/Olympus"}),
+		operatorField("a", "FormatDate", map[string]interface{}{"layout": 2006}),
+		operatorField("a", "ConvertTimezone", nil),
+		operatorField("a", "ConvertTimezone", map[string]interface{}{"to": "Nowhere"}),
+		operatorField("a", "TruncateDate", map[string]interface{}{"unit": "week"}),
+		operatorField("a", "AddToDate", nil),
+		operatorField("a", "SubtractFromDate", map[string]interface{}{"duration": "a while"}),
+		operatorField("a", "ToUnix", map[string]interface{}{"unit": "ns"}),
+		operatorField("a", "FromUnix", map[string]interface{}{"timezone": "Nowhere"}),
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{"version": "2", "fields": []interface{}{field}}); err == nil {
+			t.Errorf("expected %v to fail loading", field)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"testing"
+	"time"
+)
+
+func TestV2DateValidators(t *testing.T) {
+	now := utils.Now
+	defer func() { utils.Now = now }()
+	utils.Now = func() time.Time { return time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC) }
+
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			validatorField("birth_date", "IsBefore", map[string]interface{}{"maxTime": "now-18y", "layout": "date"}),
+			validatorField("expires_at", "IsAfter", map[string]interface{}{"minTime": "today+30d"}),
+			validatorField("created_at", "IsLessThanNow", nil),
+			validatorField("starts_at", "IsMoreThanNow", nil),
+			validatorField("european", "IsValidDate", map[string]interface{}{"layout": "02.01.2006"}),
+			validatorField("delivery", "IsBusinessDay", map[string]interface{}{"holidays": []interface{}{"2024-12-25"}}),
+			validatorField("weekend", "IsDayOfWeek", map[string]interface{}{"days": []interface{}{"Saturday", "Sunday"}}),
+			validatorField("night_shift", "IsTimeOfDayBetween", map[string]interface{}{"start": "22:00", "end": "06:00", "timezone": "Europe/Berlin"}),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := map[string]interface{}{
+		"birth_date":  "2006-06-15",
+		"expires_at":  "2024-08-01T00:00:00Z",
+		"created_at":  "2024-06-15 11:59:59",
+		"starts_at":   "2024-06-16",
+		"european":    "31.12.2024",
+		"delivery":    "2024-12-24",
+		"weekend":     "2024-06-16",
+		"night_shift": "2024-06-15T02:30:00Z",
+	}
+	if errs := schematics.Validate(valid); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	invalid := map[string]interface{}{
+		"birth_date":  "2006-06-16",
+		"expires_at":  "2024-07-01T00:00:00Z",
+		"created_at":  "2024-06-16",
+		"starts_at":   "2024-06-15",
+		"european":    "2024-12-31",
+		"delivery":    "2024-12-25",
+		"weekend":     "2024-06-17",
+		"night_shift": "2024-06-15T12:00:00Z",
+	}
+	errs := schematics.Validate(invalid)
+	if errs == nil || len(errs.Messages) != 8 {
+		t.Fatalf("expected 8 errors, got: %v", errs)
+	}
+	expected := map[string]string{
+		"birth_date":  "2006-06-16 00:00:00 is after 2006-06-15 12:00:00",
+		"delivery":    "2024-12-25 is a holiday",
+		"weekend":     "2024-06-17 is a Monday",
+		"night_shift": "14:00:00 is not between 22:00 and 06:00",
+	}
+	for target, message := range expected {
+		if e := errs.Messages[errorHandler.Target(target)]; e.Message["en"] != message {
+			t.Errorf("expected %q for %s, got: %v", message, target, e.Message)
+		}
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{"created_at": 20240615}); errs == nil {
+		t.Errorf("expected a number to be rejected as a date")
+	}
+
+	for _, attributes := range []map[string]interface{}{
+		{},
+		{"maxTime": "yesterday"},
+		{"maxTime": "now", "timezone": "Mars/Olympus"},
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{
+			"version": "2",
+			"fields":  []interface{}{validatorField("a", "IsBefore", attributes)},
+		}); err == nil {
+			t.Errorf("expected %v to fail loading", attributes)
+		}
+	}
+}
+
+func TestV2BusinessDaysInTheTimezone(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			validatorField("tokyo", "IsBusinessDay", map[string]interface{}{"timezone": "Asia/Tokyo", "holidays": []interface{}{"2024-12-25"}}),
+			validatorField("berlin", "IsWeekday", map[string]interface{}{"timezone": "Europe/Berlin"}),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"tokyo":  "2024-12-24T20:00:00Z",
+		"berlin": "2024-06-14T23:30:00Z",
+	})
+	if errs == nil || len(errs.Messages) != 2 {
+		t.Fatalf("expected 2 errors, got: %v", errs)
+	}
+	if e := errs.Messages[errorHandler.Target("tokyo")]; e.Message["en"] != "2024-12-25 is a holiday" {
+		t.Errorf("expected the holiday in Tokyo, got: %v", e.Message)
+	}
+	if e := errs.Messages[errorHandler.Target("berlin")]; e.Message["en"] != "2024-06-15 is on the weekend" {
+		t.Errorf("expected the saturday in Berlin, got: %v", e.Message)
+	}
+
+	for _, holidays := range []interface{}{"2024-12-25", []interface{}{"25.12.2024"}, []interface{}{20241225}} {
+		if _, err := v2.LoadMap(map[string]interface{}{
+			"version": "2",
+			"fields":  []interface{}{validatorField("a", "IsBusinessDay", map[string]interface{}{"holidays": holidays})},
+		}); err == nil {
+			t.Errorf("expected the holidays %v to fail loading", holidays)
+		}
+	}
+}
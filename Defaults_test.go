@@ -0,0 +1,100 @@
+package jsonschematics
+
+import (
+	v1 "github.com/DScale-io/jsonschematics/data/v1"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"testing"
+)
+
+func TestV2DefaultValues(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "user.role", "required": true, "default": "member"},
+			map[string]interface{}{"target_key": "user.id", "default_generator": "uuid"},
+			map[string]interface{}{"target_key": "user.addresses.*.country", "default": "AE"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := map[string]interface{}{
+		"user": map[string]interface{}{
+			"addresses": []interface{}{
+				map[string]interface{}{"city": "Dubai"},
+				map[string]interface{}{"city": "Lahore", "country": "PK"},
+			},
+		},
+	}
+
+	if errs := schematics.Validate(data); !errs.HasErrors() {
+		t.Error("required check should fail when the defaults are applied after validation")
+	}
+	schematics.DefaultsBeforeValidation = true
+	if errs := schematics.Validate(data); errs.HasErrors() {
+		t.Errorf("required check should see the default value, got: %v", errs.Messages)
+	}
+
+	result, errs := schematics.Operate(data)
+	if errs.HasErrors() {
+		t.Fatal(errs.Messages)
+	}
+	user := (*result.(*map[string]interface{}))["user"].(map[string]interface{})
+	addresses := user["addresses"].([]interface{})
+	if user["role"] != "member" || len(user["id"].(string)) != 36 {
+		t.Errorf("defaults were not applied: %v", user)
+	}
+	if addresses[0].(map[string]interface{})["country"] != "AE" || addresses[1].(map[string]interface{})["country"] != "PK" {
+		t.Errorf("defaults should only fill the missing array elements: %v", addresses)
+	}
+}
+
+func TestV2DefaultContainersAreCopied(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "items.*.meta", "default": map[string]interface{}{"source": "import", "tags": []interface{}{"new"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": "1"}, map[string]interface{}{"id": "2"}}}
+
+	result, errs := schematics.Operate(data)
+	if errs.HasErrors() {
+		t.Fatal(errs.Messages)
+	}
+	items := (*result.(*map[string]interface{}))["items"].([]interface{})
+	first := items[0].(map[string]interface{})["meta"].(map[string]interface{})
+	first["source"] = "changed"
+	first["tags"].([]interface{})[0] = "changed"
+	if second := items[1].(map[string]interface{})["meta"].(map[string]interface{}); second["source"] != "import" || second["tags"].([]interface{})[0] != "new" {
+		t.Errorf("expected every item to have its own copy of the default, got: %v", second)
+	}
+
+	result, _ = schematics.Operate(map[string]interface{}{"items": []interface{}{map[string]interface{}{"id": "3"}}})
+	items = (*result.(*map[string]interface{}))["items"].([]interface{})
+	if meta := items[0].(map[string]interface{})["meta"].(map[string]interface{}); meta["source"] != "import" || meta["tags"].([]interface{})[0] != "new" {
+		t.Errorf("expected the default of the schema not to change, got: %v", meta)
+	}
+}
+
+func TestV1DefaultValues(t *testing.T) {
+	schematics, err := v1.LoadMap(map[string]interface{}{
+		"version": "1",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "user.role", "default": "member"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, errs := schematics.Operate(map[string]interface{}{"user": map[string]interface{}{"name": "Ali"}})
+	if errs.HasErrors() {
+		t.Fatal(errs.Messages)
+	}
+	if user := (*result.(*map[string]interface{}))["user"].(map[string]interface{}); user["role"] != "member" {
+		t.Errorf("expected the default of the v1 schema to be applied, got: %v", user)
+	}
+}
@@ -0,0 +1,89 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/expressions"
+	"strings"
+	"testing"
+)
+
+func exprSchema(expression string) map[string]interface{} {
+	return map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "order.amount",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "Expr", "attributes": map[string]interface{}{"expression": expression}},
+				},
+			},
+		},
+		"DB": map[string]interface{}{"credit_limit": 500},
+	}
+}
+
+func TestV2ExprValidator(t *testing.T) {
+	schematics, err := v2.LoadMap(exprSchema(`value <= DB.credit_limit && $.user.age >= 18 && len(lower($.user.name)) > 2`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"user":  map[string]interface{}{"age": 30, "name": "Amira"},
+		"order": map[string]interface{}{"amount": 200},
+	}); errs.HasErrors() {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"user":  map[string]interface{}{"age": 16, "name": "Amira"},
+		"order": map[string]interface{}{"amount": 200},
+	}); !errs.HasErrors() {
+		t.Error("expected the expression to fail for an underage user")
+	}
+}
+
+func TestExprSyntaxErrorsOnLoad(t *testing.T) {
+	_, err := v2.LoadMap(exprSchema(`value <= (DB.credit_limit`))
+	if err == nil || !strings.Contains(err.Error(), "syntax error at position 25") {
+		t.Errorf("expected a syntax error on load, got: %v", err)
+	}
+
+	for source, expected := range map[string]interface{}{
+		`1 + 2 * 3`:         float64(7),
+		`"a" + "b" == "ab"`: true,
+		`daysBetween("2024-01-01", "2024-01-31")`: float64(30),
+		`!(value > 3) || value % 2 == 0`:          true,
+	} {
+		program, err := expressions.Compile(source)
+		if err != nil {
+			t.Fatalf("%s: %v", source, err)
+		}
+		result, err := program.Eval(expressions.Env{Value: 4})
+		if err != nil || result != expected {
+			t.Errorf("%s: expected %v, got %v (%v)", source, expected, result, err)
+		}
+	}
+}
+
+func TestExprIndexedPaths(t *testing.T) {
+	db := map[string]interface{}{"matrix": []interface{}{[]interface{}{"x", "y"}, []interface{}{"z"}}}
+	document := map[string]interface{}{"a.0.0": "x", "a.0.1": "y", "items.0.price": 10, "items.1.price": 2.5}
+	for source, expected := range map[string]interface{}{
+		`$.a.0.1`:                           "y",
+		`$.items.1.price`:                   2.5,
+		`$.items.0.price + $.items.1.price`: 12.5,
+		`$.items.0.price * 0.5`:             float64(5),
+		`DB.matrix.0.1`:                     "y",
+		`DB.matrix.1.0 == "z"`:              true,
+	} {
+		program, err := expressions.Compile(source)
+		if err != nil {
+			t.Fatalf("%s: %v", source, err)
+		}
+		result, err := program.Eval(expressions.Env{Document: document, DB: db})
+		if err != nil || result != expected {
+			t.Errorf("%s: expected %v, got %v (%v)", source, expected, result, err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"github.com/DScale-io/jsonschematics/validators/archives"
+	"testing"
+)
+
+func TestArchivesLookups(t *testing.T) {
+	if country, exists := archives.FindCountry("sau"); !exists || country.Alpha2 != "SA" || country.Numeric != "682" {
+		t.Errorf("expected Saudi Arabia for sau, got: %v", country)
+	}
+	if currency, exists := archives.FindCurrency("kwd"); !exists || currency.MinorUnits != 3 {
+		t.Errorf("expected 3 minor units for KWD, got: %v", currency)
+	}
+	if timezone, exists := archives.FindTimezone("asia/dubai"); !exists || timezone != "Asia/Dubai" {
+		t.Errorf("expected Asia/Dubai, got: %s", timezone)
+	}
+	for tag, valid := range map[string]bool{
+		"en":                 true,
+		"ar-SA":              true,
+		"zh-Hant-TW":         true,
+		"yue-HK":             true,
+		"sr-Latn-RS":         true,
+		"es-419":             true,
+		"de-DE-1996":         true,
+		"en-US-u-ca-gregory": true,
+		"x-internal":         true,
+		"xx-US":              false,
+		"en-Abcd":            false,
+		"en-ZZ":              false,
+		"en-":                false,
+		"en-u":               false,
+	} {
+		if err := archives.ValidateLanguageTag(tag); (err == nil) != valid {
+			t.Errorf("expected %s to be valid %v, got: %v", tag, valid, err)
+		}
+	}
+}
+
+func TestV2LocaleValidators(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			validatorField("country", "IsCountryValid", nil),
+			validatorField("country_code", "IsCountryValid", map[string]interface{}{"format": "alpha3"}),
+			validatorField("currency", "IsCurrencyCode", nil),
+			validatorField("price.amount", "IsCurrencyAmount", map[string]interface{}{"currency": map[string]interface{}{"field": "price.currency"}}),
+			validatorField("language", "IsLanguageCode", map[string]interface{}{"format": "alpha2"}),
+			validatorField("locale", "IsLanguageTag", nil),
+			validatorField("timezone", "IsTimezone", nil),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var valid map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{
+		"country": "united arab emirates", "country_code": "are", "currency": "aed",
+		"price": {"amount": 12.345, "currency": "BHD"}, "language": "AR", "locale": "ar-AE", "timezone": "Asia/Dubai"
+	}`), &valid); err != nil {
+		t.Fatal(err)
+	}
+	if errs := schematics.Validate(valid); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	var invalid map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{
+		"country": "Atlantis", "country_code": "AE", "currency": "ABC",
+		"price": {"amount": 100.5, "currency": "JPY"}, "language": "ara", "locale": "ar_AE", "timezone": "Mars/Olympus"
+	}`), &invalid); err != nil {
+		t.Fatal(err)
+	}
+	errs := schematics.Validate(invalid)
+	if errs == nil || len(errs.Messages) != 7 {
+		t.Fatalf("expected 7 errors, got: %v", errs)
+	}
+	if e := errs.Messages[errorHandler.Target("price.amount")]; e.Message["en"] != "100.5 can not have more than 0 decimal places in JPY" {
+		t.Errorf("expected the minor units error, got: %v", e.Message)
+	}
+
+	if _, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields":  []interface{}{validatorField("a", "IsCountryValid", map[string]interface{}{"format": "iso"})},
+	}); err == nil {
+		t.Error("expected an unknown format to fail loading")
+	}
+}
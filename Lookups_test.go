@@ -0,0 +1,217 @@
+package jsonschematics
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"github.com/DScale-io/jsonschematics/validators"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// fakeDriver answers every query with a row when the argument is one of its codes
+type fakeDriver struct {
+	codes   map[string]bool
+	queries int
+}
+
+type fakeConn struct{ driver *fakeDriver }
+
+type fakeStmt struct{ driver *fakeDriver }
+
+type fakeRows struct{ found bool }
+
+func (d *fakeDriver) Open(string) (driver.Conn, error)             { return &fakeConn{driver: d}, nil }
+func (d *fakeDriver) Connect(context.Context) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+func (d *fakeDriver) Driver() driver.Driver                        { return d }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{driver: c.driver}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return 1 }
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.driver.queries++
+	code, _ := args[0].(string)
+	return &fakeRows{found: s.driver.codes[code]}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"found"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if !r.found {
+		return io.EOF
+	}
+	r.found = false
+	dest[0] = int64(1)
+	return nil
+}
+
+func TestV2LookupValidators(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "branches.csv"), []byte("code,name\nDXB,Dubai\nLHE,Lahore\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "blocked.json"), []byte(`[{"email": "spam@example.com"}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	fake := &fakeDriver{codes: map[string]bool{"SKU-1": true, "SKU-2": true}}
+	db := sql.OpenDB(fake)
+	defer db.Close()
+
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "sku",
+				"validators": []interface{}{map[string]interface{}{"name": "InLookup", "attributes": map[string]interface{}{"lookup": "skus"}}},
+			},
+			map[string]interface{}{
+				"target_key": "branch",
+				"validators": []interface{}{map[string]interface{}{"name": "InLookup", "attributes": map[string]interface{}{
+					"file": filepath.Join(dir, "branches.csv"), "column": "code",
+				}}},
+			},
+			map[string]interface{}{
+				"target_key": "email",
+				"validators": []interface{}{map[string]interface{}{"name": "NotInLookup", "attributes": map[string]interface{}{
+					"file": filepath.Join(dir, "blocked.json"), "column": "email",
+				}}},
+			},
+			map[string]interface{}{
+				"target_key": "tier",
+				"validators": []interface{}{map[string]interface{}{"name": "InLookup", "attributes": map[string]interface{}{"lookup": "tiers"}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schematics.Validators.RegisterLookupProvider("skus", validators.NewSQLLookup(db, "SELECT 1 FROM skus WHERE code = ?"))
+	schematics.Validators.RegisterLookupProvider("tiers", validators.NewMapLookup("gold", "silver"))
+
+	errs := schematics.Validate([]interface{}{
+		map[string]interface{}{"sku": "SKU-1", "branch": "DXB", "email": "a@example.com", "tier": "gold"},
+		map[string]interface{}{"sku": "SKU-9", "branch": "KHI", "email": "spam@example.com", "tier": "bronze"},
+		map[string]interface{}{"sku": "SKU-1", "branch": "LHE", "email": "b@example.com", "tier": "silver"},
+	})
+	expected := map[string]string{
+		"row-1:sku":    "InLookup",
+		"row-1:branch": "InLookup",
+		"row-1:email":  "NotInLookup",
+		"row-1:tier":   "InLookup",
+	}
+	if len(errs.Messages) != len(expected) {
+		t.Errorf("expected %d errors, got: %v", len(expected), errs.Messages)
+	}
+	for target, validator := range expected {
+		if e, exists := errs.Messages[errorHandler.Target(target)]; !exists || e.Validator != validator {
+			t.Errorf("expected a %s error for %s, got: %v", validator, target, errs.Messages)
+		}
+	}
+	if fake.queries != 2 {
+		t.Errorf("expected the lookups to be cached for the run, got %d queries", fake.queries)
+	}
+
+	if _, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{map[string]interface{}{
+			"target_key": "branch",
+			"validators": []interface{}{map[string]interface{}{"name": "InLookup", "attributes": map[string]interface{}{"file": filepath.Join(dir, "missing.csv"), "column": "code"}}},
+		}},
+	}); err == nil {
+		t.Error("expected a missing lookup file to fail loading")
+	}
+}
+
+func TestV2LookupNumbersFromJSON(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "plans.json"), []byte(`[{"id": 10}, {"id": 20.0}, {"id": 12345678901234567890}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "plans.*",
+				"validators": []interface{}{map[string]interface{}{"name": "InLookup", "attributes": map[string]interface{}{
+					"file": filepath.Join(dir, "plans.json"), "column": "id",
+				}}},
+			},
+			map[string]interface{}{
+				"target_key": "level",
+				"validators": []interface{}{map[string]interface{}{"name": "NotInLookup", "attributes": map[string]interface{}{"lookup": "banned"}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schematics.Validators.RegisterLookupProvider("banned", validators.NewMapLookup(0, 1.5))
+
+	var data map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{"plans": [10, 20, 12345678901234567890, 30], "level": 1.50}`), &data); err != nil {
+		t.Fatal(err)
+	}
+	errs := schematics.Validate(data)
+	if errs == nil || len(errs.Messages) != 2 {
+		t.Fatalf("expected the errors of plans.3 and level, got: %v", errs)
+	}
+	if e := errs.Messages["plans.3"]; e.Validator != "InLookup" {
+		t.Errorf("expected 30 to be missing from the lookup, got: %v", errs.Messages)
+	}
+	if e := errs.Messages["level"]; e.Validator != "NotInLookup" {
+		t.Errorf("expected 1.50 to be found in the lookup, got: %v", errs.Messages)
+	}
+}
+
+func TestV2LookupNumbersFromCSV(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "rates.csv"), []byte("rate,zip\n7.50,04200\n10,12\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "rates.*",
+				"validators": []interface{}{map[string]interface{}{"name": "InLookup", "attributes": map[string]interface{}{
+					"file": filepath.Join(dir, "rates.csv"), "column": "rate",
+				}}},
+			},
+			map[string]interface{}{
+				"target_key": "zips.*",
+				"validators": []interface{}{map[string]interface{}{"name": "InLookup", "attributes": map[string]interface{}{
+					"file": filepath.Join(dir, "rates.csv"), "column": "zip",
+				}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{"rates": [7.5, "7.50", 10.0, 8], "zips": ["04200", 4200, 12]}`), &data); err != nil {
+		t.Fatal(err)
+	}
+	errs := schematics.Validate(data)
+	if errs == nil || len(errs.Messages) != 2 {
+		t.Fatalf("expected the errors of rates.3 and zips.1, got: %v", errs)
+	}
+	for _, target := range []errorHandler.Target{"rates.3", "zips.1"} {
+		if e := errs.Messages[target]; e.Validator != "InLookup" {
+			t.Errorf("expected %s to be missing from the lookup, got: %v", target, errs.Messages)
+		}
+	}
+}
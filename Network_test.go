@@ -0,0 +1,120 @@
+package jsonschematics
+
+import (
+	"context"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/validators"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestV2StatusCodeCheck(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "website",
+				"validators": []interface{}{map[string]interface{}{"name": "StatusCodeCheck", "attributes": map[string]interface{}{"timeout": 0.5}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schematics.Validators.Network = &validators.NetworkChecker{}
+	errs := schematics.Validate(map[string]interface{}{"website": server.URL})
+	if e, exists := errs.Messages["website"]; !exists || !strings.Contains(e.Message["en"], "is not allowed") {
+		t.Errorf("expected the loopback address to be refused, got: %v", errs.Messages)
+	}
+	if hits != 0 {
+		t.Errorf("expected no requests, got %d", hits)
+	}
+
+	schematics.Validators.Network = &validators.NetworkChecker{Client: server.Client(), AllowPrivate: true, CacheTTL: time.Minute, MaxConcurrent: 1}
+	for i := 0; i < 2; i++ {
+		if errs := schematics.Validate(map[string]interface{}{"website": server.URL + "/ok"}); errs != nil {
+			t.Errorf("expected no errors, got: %v", errs.Messages)
+		}
+	}
+	if hits != 1 {
+		t.Errorf("expected the status code to be cached, got %d requests", hits)
+	}
+	if errs := schematics.Validate(map[string]interface{}{"website": server.URL + "/missing"}); errs == nil {
+		t.Error("expected the 404 to fail the check")
+	}
+
+	schematics.Validators.Network = &validators.NetworkChecker{Client: server.Client(), AllowPrivate: true, DenyHosts: []string{"127.0.0.1"}}
+	if errs := schematics.Validate(map[string]interface{}{"website": server.URL}); errs == nil {
+		t.Error("expected the denied host to fail the check")
+	}
+
+	hits = 0
+	schematics.Validators.Network = &validators.NetworkChecker{Offline: true, AllowHosts: []string{"*.example.com"}}
+	if errs := schematics.Validate(map[string]interface{}{"website": "https://docs.example.com"}); errs != nil {
+		t.Errorf("expected no errors offline, got: %v", errs.Messages)
+	}
+	if errs := schematics.Validate(map[string]interface{}{"website": "https://example.org"}); errs == nil {
+		t.Error("expected the host outside the allowlist to fail offline")
+	}
+	if hits != 0 {
+		t.Errorf("expected no requests offline, got %d", hits)
+	}
+}
+
+func TestNetworkCheckerWithCustomClient(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "http://localhost:1/", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+
+	// the name resolves to the server only when the request connects, like a rebinding dns name
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	checker := &validators.NetworkChecker{Client: &http.Client{Transport: transport}}
+	if _, err := checker.Status("http://public.example.com/", time.Second); err == nil || !strings.Contains(err.Error(), "is not allowed") {
+		t.Errorf("expected the private address to be refused when connecting, got: %v", err)
+	}
+	checker = &validators.NetworkChecker{Client: server.Client()}
+	if _, err := checker.Status(server.URL, time.Second); err == nil {
+		t.Error("expected the loopback address to be refused with the client of the user")
+	}
+	if hits != 0 {
+		t.Errorf("expected no requests, got %d", hits)
+	}
+
+	checker = &validators.NetworkChecker{Client: server.Client(), AllowPrivate: true, AllowHosts: []string{"127.0.0.1"}}
+	if _, err := checker.Status(server.URL+"/redirect", time.Second); err == nil || !strings.Contains(err.Error(), "host localhost is not allowed") {
+		t.Errorf("expected the redirect to be checked, got: %v", err)
+	}
+
+	offline := &validators.NetworkChecker{Offline: true}
+	for _, address := range []string{"http://100.64.0.1/", "http://100.127.255.254/", "http://10.0.0.1/"} {
+		if err := offline.StatusCodeCheck(address, nil); err == nil {
+			t.Errorf("expected %s to be refused", address)
+		}
+	}
+	if err := offline.StatusCodeCheck("http://100.128.0.1/", nil); err != nil {
+		t.Errorf("expected the address outside the shared range to be allowed, got: %v", err)
+	}
+}
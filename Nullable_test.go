@@ -0,0 +1,69 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"testing"
+)
+
+func TestV2NullableAndRequiredModes(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "nickname", "type": "string", "nullable": true},
+			map[string]interface{}{"target_key": "middle_name", "type": "string"},
+			map[string]interface{}{"target_key": "country", "type": "string", "nullable": false},
+			map[string]interface{}{"target_key": "email", "type": "string", "required": true},
+			map[string]interface{}{"target_key": "bio", "type": "string", "required": true, "required_mode": "present", "nullable": true},
+			map[string]interface{}{"target_key": "tags", "type": "array", "required": true, "required_mode": "non_empty"},
+			map[string]interface{}{
+				"target_key": "deleted_at",
+				"nullable":   true,
+				"validators": []interface{}{map[string]interface{}{"name": "IsNull"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"nickname":   nil,
+		"email":      "a@b.c",
+		"bio":        nil,
+		"tags":       []interface{}{"x"},
+		"deleted_at": nil,
+	}); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"middle_name": nil,
+		"country":     nil,
+		"email":       nil,
+		"tags":        []interface{}{},
+		"deleted_at":  "2024-01-01",
+	})
+	expected := map[string]string{
+		"country":    "nullable",
+		"email":      "is-required",
+		"bio":        "is-required",
+		"tags":       "is-required",
+		"deleted_at": "IsNull",
+	}
+	if len(errs.Messages) != len(expected) {
+		t.Errorf("expected %d errors, got: %v", len(expected), errs.Messages)
+	}
+	for target, validator := range expected {
+		if e, exists := errs.Messages[errorHandler.Target(target)]; !exists || e.Validator != validator {
+			t.Errorf("expected a %s error for %s, got: %v", validator, target, errs.Messages)
+		}
+	}
+
+	if _, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields":  []interface{}{map[string]interface{}{"target_key": "a", "required": true, "required_mode": "sometimes"}},
+	}); err == nil {
+		t.Error("expected an unknown required mode to fail loading")
+	}
+}
@@ -0,0 +1,95 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"testing"
+)
+
+func validatorField(target string, name string, attributes map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"target_key": target,
+		"validators": []interface{}{map[string]interface{}{"name": name, "attributes": attributes}},
+	}
+}
+
+func TestV2NumberValidators(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			validatorField("exclusive", "ExclusiveInBetween", map[string]interface{}{"min": 0, "max": 10}),
+			validatorField("multiple", "MultipleOf", map[string]interface{}{"value": 0.05}),
+			validatorField("decimals", "MaxDecimalPlaces", map[string]interface{}{"max": 2}),
+			validatorField("precision", "DecimalPrecision", map[string]interface{}{"precision": 5, "scale": 2}),
+			validatorField("positive", "IsPositive", nil),
+			validatorField("negative", "IsNegative", nil),
+			validatorField("non_negative", "IsNonNegative", nil),
+			validatorField("percentage", "IsPercentage", map[string]interface{}{"fraction": true}),
+			validatorField("id", "IntegerInRange", nil),
+			validatorField("below_zero", "IsLesserThanZero", nil),
+			map[string]interface{}{
+				"target_key": "age",
+				"validators": []interface{}{map[string]interface{}{
+					"name":       "IntegerInRange",
+					"attributes": map[string]interface{}{"min": 18, "max": 120},
+					"error":      "age should be between {min} and {max}, got {value}",
+				}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var valid map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{
+		"exclusive": 9.99, "multiple": 1.15, "decimals": 1.5, "precision": 999.99, "positive": 1, "negative": -0.1,
+		"non_negative": 0, "percentage": 0.5, "id": 9223372036854775807, "below_zero": -3, "age": 30
+	}`), &valid); err != nil {
+		t.Fatal(err)
+	}
+	if errs := schematics.Validate(valid); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	var invalid map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{
+		"exclusive": 10, "multiple": 1.13, "decimals": 1.555, "precision": 1000.1, "positive": 0, "negative": 0,
+		"non_negative": -1, "percentage": 1.5, "id": 9223372036854775808, "below_zero": 3, "age": 12
+	}`), &invalid); err != nil {
+		t.Fatal(err)
+	}
+	errs := schematics.Validate(invalid)
+	if len(errs.Messages) != 11 {
+		t.Errorf("expected 11 errors, got: %v", errs.Messages)
+	}
+	expected := map[string]string{
+		"exclusive":  "10 should be lesser than 10",
+		"multiple":   "1.13 is not a multiple of 0.05",
+		"precision":  "1000.1 does not fit the precision 5 with 2 decimal places",
+		"id":         "9223372036854775808 should be between -9223372036854775808 and 9223372036854775807",
+		"age":        "age should be between 18 and 120, got 12",
+		"below_zero": "3 is greater than 0",
+	}
+	for target, message := range expected {
+		if e := errs.Messages[errorHandler.Target(target)]; e.Message["en"] != message {
+			t.Errorf("expected %q for %s, got: %v", message, target, e.Message)
+		}
+	}
+	if params := errs.Messages["age"].Params; params["min"] == nil || params["max"] == nil {
+		t.Errorf("expected the params on the error, got: %v", params)
+	}
+
+	for _, attributes := range []map[string]interface{}{
+		{"min": 10, "max": 0},
+		{"min": "zero", "max": 1},
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{
+			"version": "2",
+			"fields":  []interface{}{validatorField("a", "ExclusiveInBetween", attributes)},
+		}); err == nil {
+			t.Errorf("expected %v to fail loading", attributes)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package jsonschematics
+
+import (
+	"encoding/json"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/utils"
+	"testing"
+)
+
+func TestV2LosslessNumbers(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "id",
+				"type":       "integer",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "IsInteger"},
+					map[string]interface{}{"name": "MaxAllowed", "attributes": map[string]interface{}{"max": json.Number("9007199254740993")}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "price",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "IsFloat"},
+					map[string]interface{}{"name": "MinAllowed", "attributes": map[string]interface{}{"min": 0.1}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "total",
+				"operators": []interface{}{
+					map[string]interface{}{"name": "Add", "attributes": map[string]interface{}{"add_with": 0.2}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{"id": 9007199254740993, "price": 0.1, "total": 0.1}`), &data); err != nil {
+		t.Fatal(err)
+	}
+	if errs := schematics.Validate(data); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	var tooLarge map[string]interface{}
+	if err := utils.DecodeJSON([]byte(`{"id": 9007199254740994, "price": 0.1}`), &tooLarge); err != nil {
+		t.Fatal(err)
+	}
+	if errs := schematics.Validate(tooLarge); errs == nil || errs.Messages["id"].Validator != "MaxAllowed" {
+		t.Errorf("expected the id to be compared exactly, got: %v", errs)
+	}
+
+	result, errs := schematics.Operate(data)
+	if errs != nil {
+		t.Fatalf("expected no errors, got: %v", errs.Messages)
+	}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"id":9007199254740993,"price":0.1,"total":0.3}`; string(encoded) != expected {
+		t.Errorf("expected %s, got: %s", expected, encoded)
+	}
+}
@@ -0,0 +1,72 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestV2PasswordPolicy(t *testing.T) {
+	denylist := filepath.Join(t.TempDir(), "denylist.txt")
+	if err := os.WriteFile(denylist, []byte("# company words\nAcmeCorp2024!\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			validatorField("password", "PasswordPolicy", map[string]interface{}{
+				"min_length":    10,
+				"min_uppercase": 1,
+				"min_lowercase": 1,
+				"min_digits":    1,
+				"min_special":   1,
+				"min_entropy":   50,
+				"denylist_file": denylist,
+				"not_contain":   []interface{}{map[string]interface{}{"field": "username"}, map[string]interface{}{"field": "email"}},
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		password string
+		message  string
+	}{
+		{"Tr0ub4dor&3x", ""},
+		{"شمس-Qamar-42", ""},
+		{"password", "password does not meet the policy: at least 10 characters are required, at least 1 uppercase letter(s) required, " +
+			"at least 1 digit(s) required, at least 1 special character(s) required, password is too easy to guess, password is too common"},
+		{"AcmeCorp2024!", "password does not meet the policy: password is not allowed"},
+		{"Xx-jdoe-2024!", "password does not meet the policy: password can not contain the personal information"},
+		{"Q!john.smith9", "password does not meet the policy: password can not contain the personal information"},
+	}
+	for _, test := range tests {
+		errs := schematics.Validate(map[string]interface{}{
+			"username": "jdoe",
+			"email":    "john.smith@example.com",
+			"password": test.password,
+		})
+		switch {
+		case test.message == "" && errs != nil:
+			t.Errorf("expected %q to pass, got: %v", test.password, errs.Messages)
+		case test.message != "" && (errs == nil || errs.Messages["password"].Message["en"] != test.message):
+			t.Errorf("expected %q to fail with %q, got: %v", test.password, test.message, errs)
+		}
+	}
+
+	for _, attributes := range []map[string]interface{}{
+		{"min_length": 12, "max_length": 8},
+		{"min_entropy": "high"},
+		{"denylist_file": "missing.txt"},
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{
+			"version": "2",
+			"fields":  []interface{}{validatorField("password", "PasswordPolicy", attributes)},
+		}); err == nil {
+			t.Errorf("expected %v to fail loading", attributes)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/validators/archives"
+	"testing"
+)
+
+func TestPostalCodes(t *testing.T) {
+	tests := []struct {
+		country string
+		code    string
+		valid   bool
+	}{
+		{"US", "94103", true},
+		{"US", "94103-1234", true},
+		{"US", "9410", false},
+		{"CA", "K1A 0B1", true},
+		{"CA", "k1a0b1", true},
+		{"CA", "D1A 0B1", false},
+		{"GB", "SW1A 1AA", true},
+		{"GB", "EC1A1BB", true},
+		{"GB", "GIR 0AA", true},
+		{"GB", "SW1A 1A", false},
+		{"DEU", "10115", true},
+		{"Germany", "1011", false},
+		{"NL", "1012 AB", true},
+		{"PL", "00-950", true},
+		{"PL", "00950", false},
+		{"JP", "100-0001", true},
+		{"SA", "12271", true},
+		{"IE", "D02 X285", true},
+		{"AE", "00000", false},
+		{"KM", "anything", true},
+		{"KM", "", false},
+		{"Atlantis", "12345", false},
+	}
+	for _, test := range tests {
+		if err := archives.ValidatePostalCode(test.country, test.code); (err == nil) != test.valid {
+			t.Errorf("expected %q in %s to be valid %v, got: %v", test.code, test.country, test.valid, err)
+		}
+	}
+}
+
+func TestPhoneNumbers(t *testing.T) {
+	tests := []struct {
+		country    string
+		number     string
+		national   bool
+		normalized string
+	}{
+		{"", "+971 50 123 4567", false, "+971501234567"},
+		{"", "0097150-123-4567", false, "+971501234567"},
+		{"", "050 123 4567", false, ""},
+		{"", "+0123456789", false, ""},
+		{"", "+1234567890123456", false, ""},
+		{"AE", "+971501234567", false, "+971501234567"},
+		{"AE", "050 123 4567", true, "+971501234567"},
+		{"AE", "050 123 4567", false, ""},
+		{"AE", "+966501234567", false, ""},
+		{"US", "+1 (415) 555-0100", false, "+14155550100"},
+		{"US", "1 415 555 0100", true, "+14155550100"},
+		{"US", "+1 415 555 010", false, ""},
+		{"GB", "+44 20 7946 0958", false, "+442079460958"},
+		{"GB", "020 7946 0958", true, "+442079460958"},
+		{"IT", "06 6982 1234", true, "+390669821234"},
+		{"FR", "+33 6 12 34 56 78", false, "+33612345678"},
+		{"FR", "+33 6 12 34 56", false, ""},
+		{"Atlantis", "+971501234567", false, ""},
+		{"", "+97150abc4567", false, ""},
+	}
+	for _, test := range tests {
+		normalized, err := archives.ValidatePhoneNumber(test.country, test.number, test.national)
+		if (err == nil) != (test.normalized != "") || normalized != test.normalized {
+			t.Errorf("expected %q in %q to be %q, got: %q %v", test.number, test.country, test.normalized, normalized, err)
+		}
+	}
+}
+
+func TestV2PostalCodeAndPhoneValidators(t *testing.T) {
+	countryReference := map[string]interface{}{"field": "address.country"}
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			validatorField("address.postal_code", "IsPostalCode", map[string]interface{}{"country": countryReference}),
+			validatorField("contact.phone", "IsPhoneNumber", map[string]interface{}{"country": countryReference, "national": true}),
+			validatorField("branches.*.zip", "IsPostalCode", map[string]interface{}{"country": map[string]interface{}{"field": "branches.*.country"}}),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"address": map[string]interface{}{"country": "CA", "postal_code": "K1A 0B1"},
+		"contact": map[string]interface{}{"phone": "(613) 555-0100"},
+		"branches": []interface{}{
+			map[string]interface{}{"country": "US", "zip": "10001"},
+			map[string]interface{}{"country": "NL", "zip": "1012 AB"},
+		},
+	}); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"address": map[string]interface{}{"country": "US", "postal_code": "K1A 0B1"},
+		"contact": map[string]interface{}{"phone": "+44 20 7946 0958"},
+		"branches": []interface{}{
+			map[string]interface{}{"country": "NL", "zip": "10001"},
+		},
+	})
+	if errs == nil || len(errs.Messages) != 3 {
+		t.Fatalf("expected 3 errors, got: %v", errs)
+	}
+	if e := errs.Messages[errorHandler.Target("address.postal_code")]; e.Message["en"] != "K1A 0B1 is not a valid postal code in United States" {
+		t.Errorf("expected the postal code error, got: %v", e.Message)
+	}
+
+	if _, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields":  []interface{}{validatorField("zip", "IsPostalCode", map[string]interface{}{"country": "Atlantis"})},
+	}); err == nil {
+		t.Error("expected an unknown country to fail loading")
+	}
+}
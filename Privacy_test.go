@@ -0,0 +1,113 @@
+package jsonschematics
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestV2PrivacyOperators(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			operatorField("user.email", "MaskEmail", nil),
+			operatorField("user.backup_email", "MaskEmail", map[string]interface{}{"keep_first": 2, "mask_domain": true}),
+			operatorField("payment.card", "MaskCardNumber", map[string]interface{}{"keep_first": 6}),
+			operatorField("user.phone", "MaskPhone", map[string]interface{}{"character": "#"}),
+			operatorField("user.name", "Mask", map[string]interface{}{"keep_first": 1, "keep_last": 1}),
+			operatorField("user.id", "HMAC", map[string]interface{}{"key": "PII_KEY"}),
+			operatorField("user.national_id", "Hash", nil),
+			operatorField("user.notes", "Redact", nil),
+			operatorField("orders.*.address", "Redact", map[string]interface{}{"token": "***"}),
+			operatorField("user.password", "Drop", nil),
+			operatorField("payment.cvv", "Drop", nil),
+			operatorField("session", "Drop", nil),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schematics.DB = map[string]interface{}{"PII_KEY": "secret"}
+
+	result, errs := schematics.Operate(map[string]interface{}{
+		"user": map[string]interface{}{
+			"email":        "john.smith@example.com",
+			"backup_email": "jsmith@mail.example.org",
+			"phone":        "+971 50 123 4567",
+			"name":         "Mohammed",
+			"id":           "user-42",
+			"national_id":  "784-1990-1234567-1",
+			"notes":        map[string]interface{}{"medical": "none"},
+			"password":     "hunter2",
+		},
+		"payment": map[string]interface{}{"card": "4111 1111 1111 1111", "cvv": "123"},
+		"orders":  []interface{}{map[string]interface{}{"address": "1 Main St", "total": "10"}},
+		"session": map[string]interface{}{"token": "abc", "expires": "2024-01-01"},
+	})
+	if errs != nil {
+		t.Fatalf("expected no errors, got: %v", errs.Messages)
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("user-42"))
+	hash := sha256.Sum256([]byte("784-1990-1234567-1"))
+	expected := map[string]interface{}{
+		"user": map[string]interface{}{
+			"email":        "j*********@example.com",
+			"backup_email": "js****@****.*******.org",
+			"phone":        "+### ## ### 4567",
+			"name":         "M******d",
+			"id":           hex.EncodeToString(mac.Sum(nil)),
+			"national_id":  hex.EncodeToString(hash[:]),
+			"notes":        "[REDACTED]",
+		},
+		"payment": map[string]interface{}{"card": "4111 11** **** 1111"},
+		"orders":  []interface{}{map[string]interface{}{"address": "***", "total": "10"}},
+	}
+	if operated := *result.(*map[string]interface{}); !reflect.DeepEqual(operated, expected) {
+		t.Errorf("expected %v, got: %v", expected, operated)
+	}
+
+	schematics.DB = nil
+	failed := map[string]interface{}{
+		"user": map[string]interface{}{"id": "user-42", "email": "bob-at-example.com", "name": "Al"},
+	}
+	result, errs = schematics.Operate(failed)
+	if errs == nil || len(errs.Messages) != 2 {
+		t.Fatalf("expected 2 errors, got: %v", errs)
+	}
+	if e := errs.Messages[errorHandler.Target("user.id")]; e.Validator != "HMAC" || e.Message["en"] != "key PII_KEY is not in the DB" {
+		t.Errorf("expected the missing key error, got: %v", e)
+	}
+	if e := errs.Messages[errorHandler.Target("user.email")]; e.Value != "[REDACTED]" || strings.Contains(e.Message["en"], "bob") {
+		t.Errorf("expected the error not to show the email, got: %v", e)
+	}
+	user := (*result.(*map[string]interface{}))["user"].(map[string]interface{})
+	if user["id"] != "[REDACTED]" || user["email"] != "[REDACTED]" {
+		t.Errorf("expected the values to be redacted when their operators fail, got: %v", user)
+	}
+
+	object, errs := schematics.OperateOnObjectWithErrors(failed)
+	if errs == nil || len(errs.Messages) != 2 {
+		t.Errorf("expected OperateOnObjectWithErrors to return the errors, got: %v", errs)
+	}
+	if user := (*object)["user"].(map[string]interface{}); user["email"] != "[REDACTED]" {
+		t.Errorf("expected the email to be redacted, got: %v", user)
+	}
+	rows, errs := schematics.OperateOnArrayWithErrors([]map[string]interface{}{failed})
+	if errs == nil || len(errs.Messages) != 2 || len(*rows) != 1 {
+		t.Errorf("expected OperateOnArrayWithErrors to return the errors, got: %v", errs)
+	}
+
+	if _, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields":  []interface{}{operatorField("a", "HMAC", nil)},
+	}); err == nil {
+		t.Error("expected HMAC without a key to fail loading")
+	}
+}
@@ -0,0 +1,68 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"testing"
+)
+
+func TestV2CrossFieldReferences(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "booking.end",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "IsDateAfter", "attributes": map[string]interface{}{"value": map[string]interface{}{"field": "booking.start"}}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "password_confirm",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "IsEqualTo", "attributes": map[string]interface{}{"value": map[string]interface{}{"field": "password"}}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "items.*.discount",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "MaxAllowed", "attributes": map[string]interface{}{"max": map[string]interface{}{"field": "items.*.total"}}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := map[string]interface{}{
+		"booking":          map[string]interface{}{"start": "2024-01-01", "end": "2024-01-05"},
+		"password":         "secret",
+		"password_confirm": "secret",
+		"items": []interface{}{
+			map[string]interface{}{"total": 10, "discount": 10},
+			map[string]interface{}{"total": 50, "discount": 20},
+		},
+	}
+	if errs := schematics.Validate(valid); errs.HasErrors() {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	invalid := map[string]interface{}{
+		"booking":          map[string]interface{}{"start": "2024-01-05", "end": "2024-01-01"},
+		"password":         "secret",
+		"password_confirm": "other",
+		"items": []interface{}{
+			map[string]interface{}{"total": 50, "discount": 20},
+			map[string]interface{}{"total": 10, "discount": 20},
+		},
+	}
+	errs := schematics.Validate(invalid)
+	for _, target := range []string{"booking.end", "password_confirm", "items.1.discount"} {
+		if _, exists := errs.Messages[errorHandler.Target(target)]; !exists {
+			t.Errorf("expected an error for %s, got: %v", target, errs.Messages)
+		}
+	}
+	if _, exists := errs.Messages[errorHandler.Target("items.0.discount")]; exists {
+		t.Error("items.0.discount should be compared with items.0.total")
+	}
+}
@@ -0,0 +1,71 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"testing"
+)
+
+func TestV2WildcardRequiredPerElement(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "user.addresses.*.tag", "required": true, "type": "string"},
+			map[string]interface{}{"target_key": "user.addresses.*.zip", "depends_on": []interface{}{"user.addresses.*.city"}, "type": "string"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"user": map[string]interface{}{
+			"addresses": []interface{}{
+				map[string]interface{}{"tag": "home", "city": "Dubai", "zip": "00000"},
+				map[string]interface{}{"city": "Lahore"},
+				map[string]interface{}{"tag": "work", "zip": "54000"},
+			},
+		},
+	})
+	expected := map[string]string{
+		"user.addresses.1.tag": "is-required",
+		"user.addresses.2.zip": "depends-on",
+	}
+	if len(errs.Messages) != len(expected) {
+		t.Errorf("expected %d errors, got: %v", len(expected), errs.Messages)
+	}
+	for target, validator := range expected {
+		if e, exists := errs.Messages[errorHandler.Target(target)]; !exists || e.Validator != validator {
+			t.Errorf("expected a %s error for %s, got: %v", validator, target, errs.Messages)
+		}
+	}
+}
+
+func TestV2ArrayErrorsAreKeyedByRow(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{"target_key": "email", "required": true, "type": "string"},
+			map[string]interface{}{"target_key": "age", "type": "number"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schematics.ArrayIdKey = "id"
+
+	errs := schematics.Validate([]interface{}{
+		map[string]interface{}{"age": 20},
+		map[string]interface{}{"id": 7, "age": "old"},
+		map[string]interface{}{"age": 30},
+	})
+	expected := []string{"row-0:email", "7:email", "7:age", "row-2:email"}
+	if errs == nil || len(errs.Messages) != len(expected) {
+		t.Fatalf("expected %d errors, got: %v", len(expected), errs)
+	}
+	for _, target := range expected {
+		if _, exists := errs.Messages[errorHandler.Target(target)]; !exists {
+			t.Errorf("expected an error for %s, got: %v", target, errs.Messages)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package jsonschematics
+
+import (
+	"encoding/json"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"reflect"
+	"testing"
+)
+
+func TestV2StringOperators(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			operatorField("capitalized", "Capitalize", nil),
+			operatorField("empty", "Capitalize", nil),
+			operatorField("title", "TitleCase", nil),
+			operatorField("trimmed", "Trim", map[string]interface{}{"characters": "/"}),
+			operatorField("sku", "TrimPrefix", map[string]interface{}{"prefix": "SKU-"}),
+			operatorField("phone", "Replace", map[string]interface{}{"old": "-", "new": ""}),
+			operatorField("digits", "RegexReplace", map[string]interface{}{"pattern": `[^\d]+`, "replacement": ""}),
+			operatorField("summary", "Truncate", map[string]interface{}{"length": 8, "ellipsis": "…"}),
+			operatorField("invoice", "PadLeft", map[string]interface{}{"length": 6, "character": "0"}),
+			operatorField("slug", "Slugify", nil),
+			operatorField("ascii_slug", "Slugify", map[string]interface{}{"ascii": true, "separator": "_"}),
+			operatorField("tags", "Split", map[string]interface{}{"trim": true}),
+			operatorField("path", "Join", map[string]interface{}{"separator": "/"}),
+			operatorField("spaced", "CollapseWhitespace", nil),
+			operatorField("count", "UpperCase", nil),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, errs := schematics.Operate(map[string]interface{}{
+		"capitalized": "élan VITAL",
+		"empty":       "",
+		"title":       "the o'neil brothers",
+		"trimmed":     "/docs/",
+		"sku":         "SKU-1234",
+		"phone":       "050-123-4567",
+		"digits":      "+971 (50) 123",
+		"summary":     "مرحبا بالعالم",
+		"invoice":     "42",
+		"slug":        "Crème Brûlée, مرحبا!",
+		"ascii_slug":  "Crème Brûlée Straße",
+		"tags":        "go, json ,, schema",
+		"path":        []interface{}{"api", "v2", 3},
+		"spaced":      "  too \t many\n spaces ",
+		"count":       42,
+	})
+	expected := map[string]interface{}{
+		"capitalized": "Élan vital",
+		"empty":       "",
+		"title":       "The O'neil Brothers",
+		"trimmed":     "docs",
+		"sku":         "1234",
+		"phone":       "0501234567",
+		"digits":      "97150123",
+		"summary":     "مرحبا ب…",
+		"invoice":     "000042",
+		"slug":        "crème-brûlée-مرحبا",
+		"ascii_slug":  "creme_brulee_strasse",
+		"tags":        []interface{}{"go", "json", "schema"},
+		"path":        "api/v2/3",
+		"spaced":      "too many spaces",
+		"count":       json.Number("42"),
+	}
+	if operated := *result.(*map[string]interface{}); !reflect.DeepEqual(operated, expected) {
+		t.Errorf("expected %v, got: %v", expected, operated)
+	}
+	if errs == nil || len(errs.Messages) != 1 {
+		t.Fatalf("expected the error of UpperCase on a number, got: %v", errs)
+	}
+	if e := errs.Messages[errorHandler.Target("count")]; e.Validator != "UpperCase" || e.Message["en"] != "42 is not a string" {
+		t.Errorf("expected the UpperCase error, got: %v", e)
+	}
+
+	for _, field := range []map[string]interface{}{
+		operatorField("a", "RegexReplace", map[string]interface{}{"pattern": "("}),
+		operatorField("a", "Truncate", map[string]interface{}{"length": 2, "ellipsis": "..."}),
+		operatorField("a", "PadLeft", map[string]interface{}{"length": 4, "character": "ab"}),
+		operatorField("a", "Replace", map[string]interface{}{"new": "x"}),
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{"version": "2", "fields": []interface{}{field}}); err == nil {
+			t.Errorf("expected %v to fail loading", field)
+		}
+	}
+}
+
+func TestV2OperatorsRunInDeclaredOrder(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "tags",
+				"operators": []interface{}{
+					map[string]interface{}{"name": "Split", "attributes": map[string]interface{}{"separator": ","}},
+					map[string]interface{}{"name": "Join", "attributes": map[string]interface{}{"separator": "|"}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "title",
+				"operators": []interface{}{
+					map[string]interface{}{"name": "CollapseWhitespace"},
+					map[string]interface{}{"name": "Truncate", "attributes": map[string]interface{}{"length": 5}},
+					map[string]interface{}{"name": "UpperCase"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"tags": "a|b", "title": "AB CD"}
+	for i := 0; i < 100; i++ {
+		result, errs := schematics.Operate(map[string]interface{}{"tags": "a,b", "title": "   ab    cdef"})
+		if errs != nil {
+			t.Fatalf("expected no errors, got: %v", errs)
+		}
+		if operated := *result.(*map[string]interface{}); !reflect.DeepEqual(operated, expected) {
+			t.Fatalf("expected %v, got: %v", expected, operated)
+		}
+	}
+}
+
+func TestV2TruncateAndPadByGraphemes(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			operatorField("accents", "Truncate", map[string]interface{}{"length": 2, "mode": "graphemes"}),
+			operatorField("family", "Truncate", map[string]interface{}{"length": 2, "ellipsis": "…", "mode": "graphemes"}),
+			operatorField("flags", "Truncate", map[string]interface{}{"length": 1, "mode": "graphemes"}),
+			operatorField("runes", "Truncate", map[string]interface{}{"length": 2}),
+			operatorField("bytes", "Truncate", map[string]interface{}{"length": 4, "mode": "bytes"}),
+			operatorField("padded", "PadLeft", map[string]interface{}{"length": 3, "character": "0", "mode": "graphemes"}),
+			operatorField("bytes_padded", "PadRight", map[string]interface{}{"length": 5, "mode": "bytes", "character": "."}),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, errs := schematics.Operate(map[string]interface{}{
+		"accents":      "e\u0301e\u0301e\u0301",
+		"family":       "\U0001F468\u200d\U0001F469\u200d\U0001F467 family",
+		"flags":        "\U0001F1E6\U0001F1EA\U0001F1F5\U0001F1F0",
+		"runes":        "ae\u0301x",
+		"bytes":        "cafe\u0301s",
+		"padded":       "e\u0301",
+		"bytes_padded": "caf",
+	})
+	if errs != nil {
+		t.Fatalf("expected no errors, got: %v", errs.Messages)
+	}
+	expected := map[string]interface{}{
+		"accents":      "e\u0301e\u0301",
+		"family":       "\U0001F468\u200d\U0001F469\u200d\U0001F467…",
+		"flags":        "\U0001F1E6\U0001F1EA",
+		"runes":        "a",
+		"bytes":        "caf",
+		"padded":       "00e\u0301",
+		"bytes_padded": "caf..",
+	}
+	if operated := *result.(*map[string]interface{}); !reflect.DeepEqual(operated, expected) {
+		t.Errorf("expected %q, got: %q", expected, operated)
+	}
+
+	for _, field := range []map[string]interface{}{
+		operatorField("a", "Truncate", map[string]interface{}{"length": 2, "mode": "words"}),
+		operatorField("a", "PadLeft", map[string]interface{}{"length": 2, "character": "e\u0301e"}),
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{"version": "2", "fields": []interface{}{field}}); err == nil {
+			t.Errorf("expected %v to fail loading", field)
+		}
+	}
+
+	// the operators count like the length validators, so a truncated value passes the same limit
+	limited, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{map[string]interface{}{
+			"target_key": "title",
+			"operators":  []interface{}{map[string]interface{}{"name": "Truncate", "attributes": map[string]interface{}{"length": 4}}},
+			"validators": []interface{}{map[string]interface{}{"name": "MaxLengthAllowed", "attributes": map[string]interface{}{"max": 4}}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	truncated, _ := limited.Operate(map[string]interface{}{"title": "cafe\u0301 au lait"})
+	if errs := limited.Validate(*truncated.(*map[string]interface{})); errs != nil {
+		t.Errorf("expected the truncated title to fit, got: %v", errs.Messages)
+	}
+}
@@ -0,0 +1,90 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"testing"
+)
+
+func TestGraphemeCount(t *testing.T) {
+	for str, expected := range map[string]int{
+		"محمد":    4,
+		"e\u0301": 1,
+		"\U0001F468\u200D\U0001F469\u200D\U0001F467": 1,
+		"🇩🇪🇫🇷":                                       2,
+		"👍🏽":                                         1,
+		"한국어":                                        3,
+		"a\r\nb":                                     3,
+		"نِسْرِين":                                   5,
+		"ascii letters":                              13,
+	} {
+		if count := utils.GraphemeCount(str); count != expected {
+			t.Errorf("expected %d graphemes in %q, got %d", expected, str, count)
+		}
+	}
+}
+
+func TestV2UnicodeStringValidators(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			validatorField("name", "MaxLengthAllowed", map[string]interface{}{"max": 10}),
+			validatorField("bytes", "MaxLengthAllowed", map[string]interface{}{"max": 10, "mode": "bytes"}),
+			validatorField("emoji", "InBetweenLengthAllowed", map[string]interface{}{"min": 1, "max": 2, "mode": "graphemes"}),
+			validatorField("username", "NoSpecialCharacters", map[string]interface{}{"allow": "_"}),
+			validatorField("password", "HaveSpecialCharacters", nil),
+			validatorField("arabic", "AllowedScripts", map[string]interface{}{"scripts": []interface{}{"Latin", "Arabic"}, "mixed": false}),
+			validatorField("comment", "NoControlCharacters", map[string]interface{}{"multiline": true}),
+			validatorField("label", "IsPrintable", nil),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"name":     "عبد الرحمن",
+		"bytes":    "abcdefghij",
+		"emoji":    "\U0001F468\u200D\U0001F469\u200D\U0001F467👍🏽",
+		"username": "josé_1",
+		"password": "secret!",
+		"arabic":   "محمد 42",
+		"comment":  "first line\nsecond line",
+		"label":    "Crème brûlée 🍮",
+	}); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"name":     "عبد الرحمن بن",
+		"bytes":    "محمد محمد",
+		"emoji":    "👍👍👍",
+		"username": "josé-1",
+		"password": "secret",
+		"arabic":   "Mohamed محمد",
+		"comment":  "evil\u202Etxt.exe",
+		"label":    "tab\there",
+	})
+	if errs == nil || len(errs.Messages) != 8 {
+		t.Fatalf("expected 8 errors, got: %v", errs)
+	}
+	expected := map[string]string{
+		"arabic":  "Latin and Arabic characters can not be mixed",
+		"comment": "control character U+202E is not allowed",
+	}
+	for target, message := range expected {
+		if e := errs.Messages[errorHandler.Target(target)]; e.Message["en"] != message {
+			t.Errorf("expected %q for %s, got: %v", message, target, e.Message)
+		}
+	}
+
+	for _, field := range []map[string]interface{}{
+		validatorField("a", "MaxLengthAllowed", map[string]interface{}{"max": 1, "mode": "letters"}),
+		validatorField("a", "AllowedScripts", map[string]interface{}{"scripts": []interface{}{"Klingon"}}),
+	} {
+		if _, err := v2.LoadMap(map[string]interface{}{"version": "2", "fields": []interface{}{field}}); err == nil {
+			t.Errorf("expected %v to fail loading", field)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package jsonschematics
+
+import (
+	"encoding/json"
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"reflect"
+	"testing"
+)
+
+func TestV2StructureOperators(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			operatorField("legacy.*.name", "Move", map[string]interface{}{"to": "people.*.full_name"}),
+			map[string]interface{}{
+				"target_key": "legacy.*.age",
+				"type":       "integer",
+				"output_key": "people.*.age",
+			},
+			operatorField("customer.mail", "Rename", map[string]interface{}{"to": "email"}),
+			operatorField("customer.id", "Copy", map[string]interface{}{"to": "meta.customer_id"}),
+			operatorField("internal", "Delete", nil),
+			map[string]interface{}{
+				"target_key": "address",
+				"required":   true,
+				"output_key": "shipping.address",
+			},
+			map[string]interface{}{
+				"target_key": "code",
+				"output_key": "shipping.code",
+				"operators":  []interface{}{map[string]interface{}{"name": "UpperCase"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inbound := map[string]interface{}{
+		"legacy": []interface{}{
+			map[string]interface{}{"name": "Ada Lovelace", "age": 36},
+			map[string]interface{}{"name": "Alan Turing", "age": 41},
+		},
+		"customer": map[string]interface{}{"id": "c-1", "mail": "ada@example.com"},
+		"internal": map[string]interface{}{"trace": "x", "flags": []interface{}{"a"}},
+		"address":  map[string]interface{}{"city": "London", "lines": []interface{}{"12 St James's Square"}},
+		"code":     "ldn",
+	}
+	if errs := schematics.Validate(inbound); errs != nil {
+		t.Fatalf("expected the inbound payload to be valid, got: %v", errs.Messages)
+	}
+
+	result, errs := schematics.Operate(inbound)
+	if errs != nil {
+		t.Fatalf("expected no errors, got: %v", errs.Messages)
+	}
+	expected := map[string]interface{}{
+		"people": []interface{}{
+			map[string]interface{}{"full_name": "Ada Lovelace", "age": json.Number("36")},
+			map[string]interface{}{"full_name": "Alan Turing", "age": json.Number("41")},
+		},
+		"customer": map[string]interface{}{"id": "c-1", "email": "ada@example.com"},
+		"meta":     map[string]interface{}{"customer_id": "c-1"},
+		"shipping": map[string]interface{}{
+			"address": map[string]interface{}{"city": "London", "lines": []interface{}{"12 St James's Square"}},
+			"code":    "LDN",
+		},
+	}
+	if operated := *result.(*map[string]interface{}); !reflect.DeepEqual(operated, expected) {
+		t.Errorf("expected %v, got: %v", expected, operated)
+	}
+
+	if _, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields":  []interface{}{operatorField("a", "Move", nil)},
+	}); err == nil {
+		t.Error("expected Move without to to fail loading")
+	}
+}
+
+func TestV2CopyKeepsTheOperatedValue(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "name",
+				"operators": []interface{}{
+					map[string]interface{}{"name": "Trim"},
+					map[string]interface{}{"name": "Copy", "attributes": map[string]interface{}{"to": "display"}},
+				},
+			},
+			map[string]interface{}{
+				"target_key": "tags",
+				"operators": []interface{}{
+					map[string]interface{}{"name": "Split"},
+					map[string]interface{}{"name": "Copy", "attributes": map[string]interface{}{"to": "labels"}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, errs := schematics.Operate(map[string]interface{}{"name": "  Bob  ", "tags": "a,b"})
+	if errs != nil {
+		t.Fatalf("expected no errors, got: %v", errs.Messages)
+	}
+	expected := map[string]interface{}{
+		"name":    "Bob",
+		"display": "Bob",
+		"tags":    []interface{}{"a", "b"},
+		"labels":  []interface{}{"a", "b"},
+	}
+	if operated := *result.(*map[string]interface{}); !reflect.DeepEqual(operated, expected) {
+		t.Errorf("expected %v, got: %v", expected, operated)
+	}
+}
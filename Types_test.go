@@ -0,0 +1,53 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"testing"
+)
+
+func TestV2FieldTypes(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "user.age",
+				"type":       "integer",
+				"validators": []interface{}{
+					map[string]interface{}{"name": "MaxAllowed", "attributes": map[string]interface{}{"max": 120}},
+				},
+			},
+			map[string]interface{}{"target_key": "user.email", "type": "string", "format": "email"},
+			map[string]interface{}{"target_key": "user.birthday", "type": "date"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if errs := schematics.Validate(map[string]interface{}{
+		"user": map[string]interface{}{"age": 42, "email": "amira@example.com", "birthday": "1990-04-02"},
+	}); errs.HasErrors() {
+		t.Errorf("expected no errors, got: %v", errs.Messages)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"user": map[string]interface{}{"age": "42", "email": "not-an-email", "birthday": "02/04/1990"},
+	})
+	expected := map[string]string{"user.age": "type", "user.email": "format", "user.birthday": "type"}
+	for target, validator := range expected {
+		e, exists := errs.Messages[errorHandler.Target(target)]
+		if !exists || e.Validator != validator {
+			t.Errorf("expected a %s error for %s, got: %v", validator, target, e)
+		}
+	}
+	if message := errs.Messages["user.age"].Message["en"]; message != "expected integer but got string" {
+		t.Errorf("unexpected type error message: %s", message)
+	}
+
+	if _, err := v2.LoadMap(map[string]interface{}{
+		"fields": []interface{}{map[string]interface{}{"target_key": "x", "type": "text"}},
+	}); err == nil {
+		t.Error("expected unknown types to fail on load")
+	}
+}
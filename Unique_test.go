@@ -0,0 +1,70 @@
+package jsonschematics
+
+import (
+	v2 "github.com/DScale-io/jsonschematics/data/v2"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"testing"
+)
+
+func TestV2UniqueAcrossRows(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "email",
+				"type":       "string",
+				"unique":     map[string]interface{}{"scope": "rows", "case_insensitive": true},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := schematics.Validate([]interface{}{
+		map[string]interface{}{"email": "ada@example.com"},
+		map[string]interface{}{"email": "alan@example.com"},
+		map[string]interface{}{"email": "Ada@Example.com"},
+		map[string]interface{}{"email": "ADA@example.com"},
+	})
+	if len(errs.Messages) != 2 {
+		t.Errorf("expected 2 errors, got: %v", errs.Messages)
+	}
+	for _, target := range []string{"row-2:email", "row-3:email"} {
+		e, exists := errs.Messages[errorHandler.Target(target)]
+		if !exists || e.Validator != "unique" || e.Message["en"] != "value is a duplicate of row-0:email" {
+			t.Errorf("expected a unique error for %s, got: %v", target, errs.Messages)
+		}
+	}
+}
+
+func TestV2UniqueWithinMatches(t *testing.T) {
+	schematics, err := v2.LoadMap(map[string]interface{}{
+		"version": "2",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"target_key": "items.*.sku",
+				"type":       "string",
+				"unique":     map[string]interface{}{"with": []interface{}{"items.*.size"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := schematics.Validate(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "shirt", "size": "m"},
+			map[string]interface{}{"sku": "shirt", "size": "l"},
+			map[string]interface{}{"sku": "hat", "size": "m"},
+			map[string]interface{}{"sku": "shirt", "size": "m"},
+		},
+	})
+	if len(errs.Messages) != 1 {
+		t.Errorf("expected 1 error, got: %v", errs.Messages)
+	}
+	if e, exists := errs.Messages["items.3.sku"]; !exists || e.Message["en"] != "value is a duplicate of items.0.sku" {
+		t.Errorf("expected items.3.sku to be a duplicate of items.0.sku, got: %v", errs.Messages)
+	}
+}
@@ -0,0 +1,150 @@
+package v0
+
+import (
+	"fmt"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"regexp"
+	"strings"
+)
+
+const (
+	AdditionalKeysAllow  = "allow"
+	AdditionalKeysReject = "reject"
+	AdditionalKeysStrip  = "strip"
+)
+
+// AdditionalKeys decides what happens to the keys of the data which are not covered by any field,
+// Overrides change the policy for a subtree, in the override keys * matches any single key e.g. "metadata.*"
+type AdditionalKeys struct {
+	Policy    string            `json:"policy"`
+	Overrides map[string]string `json:"overrides"`
+}
+
+func checkAdditionalKeysPolicy(policy string) error {
+	switch policy {
+	case "", AdditionalKeysAllow, AdditionalKeysReject, AdditionalKeysStrip:
+		return nil
+	}
+	return fmt.Errorf("unknown additional keys policy %s", policy)
+}
+
+func (a *AdditionalKeys) validate() error {
+	if err := checkAdditionalKeysPolicy(a.Policy); err != nil {
+		return err
+	}
+	for subtree, policy := range a.Overrides {
+		if err := checkAdditionalKeysPolicy(policy); err != nil {
+			return fmt.Errorf("%s: %v", subtree, err)
+		}
+	}
+	return nil
+}
+
+func (a *AdditionalKeys) isAllowingAll() bool {
+	if a.Policy != "" && a.Policy != AdditionalKeysAllow {
+		return false
+	}
+	for _, policy := range a.Overrides {
+		if policy != AdditionalKeysAllow {
+			return false
+		}
+	}
+	return true
+}
+
+// ancestors returns the key itself and all of its parent keys
+func ancestors(key string, separator string) []string {
+	parts := strings.Split(key, separator)
+	keys := make([]string, 0, len(parts))
+	for i := len(parts); i > 0; i-- {
+		keys = append(keys, strings.Join(parts[:i], separator))
+	}
+	return keys
+}
+
+func subtreeRegex(pattern string, separator string) *regexp.Regexp {
+	escaped := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, "[^"+regexp.QuoteMeta(separator)+"]+")
+	return regexp.MustCompile("^" + escaped + "$")
+}
+
+// policyFor returns the policy of the most specific override containing the key
+func (a *AdditionalKeys) policyFor(key string, separator string) string {
+	policy := a.Policy
+	matchedLength := -1
+	for subtree, override := range a.Overrides {
+		length := len(strings.Split(subtree, separator))
+		if length <= matchedLength {
+			continue
+		}
+		re := subtreeRegex(subtree, separator)
+		for _, parent := range ancestors(key, separator) {
+			if re.MatchString(parent) {
+				policy = override
+				matchedLength = length
+				break
+			}
+		}
+	}
+	if policy == "" {
+		return AdditionalKeysAllow
+	}
+	return policy
+}
+
+// additionalKeys returns the keys of the flat data which are not covered by any target of the schema,
+// a key is covered if the key or one of its parents matches a target
+func (s *Schematics) additionalKeys(flatData map[string]interface{}) map[string]string {
+	unknown := make(map[string]string)
+	if s.Schema.AdditionalKeys.isAllowingAll() {
+		return unknown
+	}
+	var targets []*regexp.Regexp
+	for target := range s.Schema.Fields {
+		targets = append(targets, regexp.MustCompile(utils.ConvertKeyToRegex(string(target))))
+	}
+	for key := range flatData {
+		covered := false
+		for _, parent := range ancestors(key, s.Separator) {
+			for _, re := range targets {
+				if re.MatchString(parent) {
+					covered = true
+					break
+				}
+			}
+			if covered {
+				break
+			}
+		}
+		if !covered {
+			policy := s.Schema.AdditionalKeys.policyFor(key, s.Separator)
+			if policy != AdditionalKeysAllow {
+				unknown[key] = policy
+			}
+		}
+	}
+	return unknown
+}
+
+func (s *Schematics) rejectAdditionalKeys(flatData map[string]interface{}, id interface{}, errs *errorHandler.Errors) {
+	for key, policy := range s.additionalKeys(flatData) {
+		if policy != AdditionalKeysReject {
+			continue
+		}
+		var keyError errorHandler.Error
+		keyError.Validator = "additional-keys"
+		keyError.Value = flatData[key]
+		keyError.ID = id
+		keyError.AddMessage("en", "key is not allowed by the schema")
+		errs.AddError(key, keyError)
+	}
+}
+
+func (s *Schematics) stripAdditionalKeys(flatData map[string]interface{}) {
+	for key, policy := range s.additionalKeys(flatData) {
+		if policy == AdditionalKeysStrip {
+			s.Logging.DEBUG("stripping the additional key", key)
+			delete(flatData, key)
+		}
+	}
+}
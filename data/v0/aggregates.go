@@ -0,0 +1,37 @@
+package v0
+
+import (
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/validators"
+)
+
+// aggregate runs the aggregate validators of the field on all the matching values together,
+// null values are left out
+func (f *Field) aggregate(matchingKeys map[string]interface{}, allAggregates map[string]validators.AggregateValidator, id interface{}, db map[string]interface{}, separator string) *errorHandler.Error {
+	var values []interface{}
+	for _, key := range sortKeys(matchingKeys, separator) {
+		if matchingKeys[key] != nil {
+			values = append(values, matchingKeys[key])
+		}
+	}
+	var err errorHandler.Error
+	err.Value = values
+	err.ID = id
+	for name, constants := range f.Aggregates {
+		err.Validator = name
+		fn, exists := allAggregates[name]
+		if !exists {
+			f.logging.ERROR("aggregate validator not found", name)
+			err.AddMessage("en", "aggregate validator not registered")
+			return &err
+		}
+		attributes := f.resolveAttributes(constants.Attributes, string(f.target))
+		attributes["DB"] = db
+		attributes["DOCUMENT"] = f.document
+		if fnError := fn(values, attributes); fnError != nil && fnError.Error() != "" {
+			f.addMessages(&err, name, constants, fnError)
+			return &err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package v0
+
+import (
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"github.com/DScale-io/jsonschematics/validators"
+)
+
+// coerce converts the values in the flat data to the types of their fields,
+// the keys which could not be converted are returned so they are not validated again
+func (s *Schematics) coerce(flatData map[string]interface{}, id interface{}, errs *errorHandler.Errors) map[string]bool {
+	failed := make(map[string]bool)
+	for target, field := range s.Schema.Fields {
+		if field.Type == "" {
+			continue
+		}
+		for key, value := range utils.FindMatchingKeys(flatData, string(target)) {
+			if value == nil {
+				continue
+			}
+			converted, err := validators.Coerce(field.Type, value)
+			if err != nil {
+				s.Logging.DEBUG("failed to coerce", key, err)
+				var coercionError errorHandler.Error
+				coercionError.Validator = "coerce"
+				coercionError.Value = value
+				coercionError.ID = id
+				coercionError.AddMessage("en", err.Error())
+				errs.AddError(key, coercionError)
+				failed[key] = true
+				continue
+			}
+			flatData[key] = converted
+		}
+	}
+	return failed
+}
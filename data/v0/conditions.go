@@ -0,0 +1,73 @@
+package v0
+
+import (
+	"github.com/DScale-io/jsonschematics/utils"
+)
+
+// Condition is the "when" clause of a field, leaf conditions check the values of target_key
+// and groups can be made with all, any and not
+type Condition struct {
+	TargetKey string      `json:"target_key"`
+	Operator  string      `json:"operator"`
+	Value     interface{} `json:"value"`
+	All       []Condition `json:"all"`
+	Any       []Condition `json:"any"`
+	Not       *Condition  `json:"not"`
+}
+
+// Evaluate returns true when the condition holds for the flat data,
+// a leaf condition holds when at least one of the matching values satisfies the operator
+func (c *Condition) Evaluate(flatData map[string]interface{}) (bool, error) {
+	if c.Not != nil {
+		holds, err := c.Not.Evaluate(flatData)
+		if err != nil {
+			return false, err
+		}
+		if holds {
+			return false, nil
+		}
+	}
+
+	for _, condition := range c.All {
+		holds, err := condition.Evaluate(flatData)
+		if err != nil || !holds {
+			return false, err
+		}
+	}
+
+	if len(c.Any) > 0 {
+		anyHolds := false
+		for _, condition := range c.Any {
+			holds, err := condition.Evaluate(flatData)
+			if err != nil {
+				return false, err
+			}
+			if holds {
+				anyHolds = true
+				break
+			}
+		}
+		if !anyHolds {
+			return false, nil
+		}
+	}
+
+	if c.TargetKey == "" {
+		return true, nil
+	}
+
+	matchingKeys := utils.FindMatchingKeys(flatData, c.TargetKey)
+	if len(matchingKeys) == 0 {
+		return c.Operator == utils.OpNotExists, nil
+	}
+	for _, value := range matchingKeys {
+		holds, err := utils.EvaluatePredicate(c.Operator, value, c.Value)
+		if err != nil {
+			return false, err
+		}
+		if holds {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,51 @@
+package v0
+
+import (
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"time"
+)
+
+const (
+	DefaultGeneratorNow  = "now"
+	DefaultGeneratorUUID = "uuid"
+)
+
+func (f *Field) hasDefault() bool {
+	return f.Default != nil || f.DefaultGenerator != ""
+}
+
+// defaultValue gives every key its own copy of the default, so an operator changing one of them does not change the others
+func (f *Field) defaultValue() interface{} {
+	switch f.DefaultGenerator {
+	case DefaultGeneratorNow:
+		return utils.Now().UTC().Format(time.RFC3339)
+	case DefaultGeneratorUUID:
+		return utils.NewUUID()
+	}
+	return utils.DeepCopy(f.Default)
+}
+
+func checkDefaultGenerator(generator string) error {
+	switch generator {
+	case "", DefaultGeneratorNow, DefaultGeneratorUUID:
+		return nil
+	}
+	return fmt.Errorf("unknown default generator %s", generator)
+}
+
+// applyDefaults inserts the default values into the flat data for the target keys that are missing,
+// wildcard targets get the default inside every existing element of the array
+func (s *Schematics) applyDefaults(flatData map[string]interface{}) {
+	for target, field := range s.Schema.Fields {
+		if !field.hasDefault() {
+			continue
+		}
+		for _, key := range utils.ExpandWildcardKey(flatData, string(target), s.Separator) {
+			if !utils.KeyExists(flatData, key, s.Separator) {
+				s.Logging.DEBUG("applying the default value", key)
+				flatData[key] = field.defaultValue()
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package v0
+
+import (
+	"github.com/DScale-io/jsonschematics/utils"
+)
+
+// ReferenceKey is used in the attributes to point to the value of another target key,
+// {"max": {"field": "order.total"}} will be passed to the validator as the value of order.total
+const ReferenceKey = "field"
+
+func asReference(value interface{}) (string, bool) {
+	ref, ok := value.(map[string]interface{})
+	if !ok || len(ref) != 1 {
+		return "", false
+	}
+	key, ok := ref[ReferenceKey].(string)
+	return key, ok
+}
+
+// resolveAttributes copies the attributes and replaces the references with the values from the document,
+// wildcards in the references are resolved relative to the key being validated
+func (f *Field) resolveAttributes(attributes map[string]interface{}, key string) map[string]interface{} {
+	resolved := make(map[string]interface{}, len(attributes)+1)
+	for name, value := range attributes {
+		resolved[name] = f.resolveValue(value, key)
+	}
+	return resolved
+}
+
+func (f *Field) resolveValue(value interface{}, key string) interface{} {
+	if reference, ok := asReference(value); ok {
+		reference = utils.ResolveRelativeKey(string(f.target), key, reference)
+		resolvedValue, exists := utils.GetValueAt(f.document, reference)
+		f.logging.DEBUG("resolved reference", reference, exists)
+		return resolvedValue
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return f.resolveAttributes(v, key)
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i, item := range v {
+			values[i] = f.resolveValue(item, key)
+		}
+		return values
+	}
+	return value
+}
@@ -0,0 +1,53 @@
+package v0
+
+import (
+	"github.com/DScale-io/jsonschematics/operators"
+	"github.com/DScale-io/jsonschematics/utils"
+	"strings"
+)
+
+type relocation struct {
+	from string
+	to   string
+	operators.Relocation
+}
+
+// relocationOf resolves the key the value goes to, the wildcards of the key are replaced with the indices
+// of the matched key and a rename keeps the parent of the matched key
+func (s *Schematics) relocationOf(target TargetKey, key string, moved operators.Relocation) relocation {
+	to := moved.To
+	if moved.Rename {
+		if last := strings.LastIndex(key, s.Separator); last >= 0 {
+			to = key[:last+len(s.Separator)] + moved.To
+		}
+	} else {
+		to = utils.ResolveRelativeKey(string(target), key, moved.To)
+	}
+	return relocation{from: key, to: to, Relocation: moved}
+}
+
+// relocate moves the values in the flat data, the moved values are removed first so the keys can be swapped,
+// a copied value stays at its key with the results of the operators before the copy.
+// Arrays and objects are flattened again at their new keys
+func (s *Schematics) relocate(data map[string]interface{}, relocations []relocation) {
+	for _, r := range relocations {
+		utils.RemoveChildKeys(data, r.from, s.Separator)
+		delete(data, r.from)
+		if r.Copy {
+			s.place(data, r.from, r.Value)
+		}
+	}
+	for _, r := range relocations {
+		s.place(data, r.to, r.Value)
+	}
+}
+
+// place writes the value at the key of the flat data, replacing what was under it
+func (s *Schematics) place(data map[string]interface{}, key string, value interface{}) {
+	utils.RemoveChildKeys(data, key, s.Separator)
+	var placed utils.DataMap
+	placed.FlattenTheMap(map[string]interface{}{key: value}, "", s.Separator)
+	for k, v := range placed.Data {
+		data[k] = v
+	}
+}
@@ -0,0 +1,91 @@
+package v0
+
+import (
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"reflect"
+	"strings"
+)
+
+const (
+	// RequiredPresent only needs the key, null and empty values are accepted
+	RequiredPresent = "present"
+	// RequiredNonNull needs the key with a value other than null, this is the default
+	RequiredNonNull = "non_null"
+	// RequiredNonEmpty also rejects empty strings, arrays and objects
+	RequiredNonEmpty = "non_empty"
+)
+
+func checkRequiredMode(mode string) error {
+	switch mode {
+	case "", RequiredPresent, RequiredNonNull, RequiredNonEmpty:
+		return nil
+	}
+	return fmt.Errorf("unknown required mode %s", mode)
+}
+
+// requiredValueError returns why the value does not satisfy the required mode of the field
+func (f *Field) requiredValueError(value interface{}) string {
+	if f.RequiredMode == RequiredPresent {
+		return ""
+	}
+	if value == nil {
+		return "field is required and can not be null"
+	}
+	if f.RequiredMode == RequiredNonEmpty && isEmptyValue(value) {
+		return "field is required and can not be empty"
+	}
+	return ""
+}
+
+func isEmptyValue(value interface{}) bool {
+	if str, ok := value.(string); ok {
+		return strings.TrimSpace(str) == ""
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// missingKeys returns the required keys which are missing or whose values do not satisfy the required mode,
+// wildcard targets are checked for every array element so "user.addresses.*.tag" reports "user.addresses.2.tag"
+// when only the third address has no tag
+func (s *Schematics) missingKeys(flatData map[string]interface{}, field *Field, matchingKeys map[string]interface{}) map[string]string {
+	target := string(field.target)
+	missing := make(map[string]string)
+	if !strings.Contains(target, "*") {
+		if len(matchingKeys) == 0 {
+			if !utils.KeyExists(flatData, target, s.Separator) {
+				missing[target] = "field is required"
+			}
+			return missing
+		}
+		for key, value := range matchingKeys {
+			if message := field.requiredValueError(value); message != "" {
+				missing[key] = message
+			}
+		}
+		return missing
+	}
+	expectedKeys := utils.ExpandWildcardKey(flatData, target, s.Separator)
+	if len(expectedKeys) == 0 && len(matchingKeys) == 0 {
+		missing[target] = "field is required"
+		return missing
+	}
+	for _, key := range expectedKeys {
+		value, exists := flatData[key]
+		if !exists {
+			if !utils.KeyExists(flatData, key, s.Separator) {
+				missing[key] = "field is required"
+			}
+			continue
+		}
+		if message := field.requiredValueError(value); message != "" {
+			missing[key] = message
+		}
+	}
+	return missing
+}
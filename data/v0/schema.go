@@ -2,6 +2,7 @@ package v0
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/DScale-io/jsonschematics/errorHandler"
 	"github.com/DScale-io/jsonschematics/operators"
@@ -9,6 +10,7 @@ import (
 	"github.com/DScale-io/jsonschematics/validators"
 	"log"
 	"os"
+	"sort"
 	"strings"
 )
 
@@ -23,14 +25,19 @@ type Schematics struct {
 	Locale     string
 	DB         map[string]interface{}
 	Logging    utils.Logger
+	// DefaultsBeforeValidation fills the default values before validating, so the required checks can see them
+	DefaultsBeforeValidation bool
+	// CoerceTypes converts the values to the type of their fields before validating and operating
+	CoerceTypes bool
 }
 
 // add this DB to the attributes as SCHEMA_GLOBAL_DB
 
 type Schema struct {
-	Version string                 `json:"version"`
-	Fields  map[TargetKey]Field    `json:"fields"`
-	DB      map[string]interface{} `json:"DB"`
+	Version        string                 `json:"version"`
+	Fields         map[TargetKey]Field    `json:"fields"`
+	DB             map[string]interface{} `json:"DB"`
+	AdditionalKeys AdditionalKeys         `json:"additional_keys"`
 }
 
 type Field struct {
@@ -38,14 +45,35 @@ type Field struct {
 	DisplayName           string                 `json:"display_name"`
 	Name                  string                 `json:"name"`
 	Type                  string                 `json:"type"`
+	Format                string                 `json:"format"`
 	IsRequired            bool                   `json:"required"`
+	RequiredMode          string                 `json:"required_mode"`
+	Nullable              *bool                  `json:"nullable"`
+	Default               interface{}            `json:"default"`
+	DefaultGenerator      string                 `json:"default_generator"`
 	AddToDB               bool                   `json:"add_to_db"`
 	Description           string                 `json:"description"`
 	Validators            map[string]Constant    `json:"validators"`
+	Aggregates            map[string]Constant    `json:"aggregates"`
 	Operators             map[string]Constant    `json:"operators"`
+	Operations            []Operation            `json:"-"`
+	OutputKey             string                 `json:"output_key"`
+	Unique                *Unique                `json:"unique"`
+	When                  *Condition             `json:"when"`
+	Else                  map[string]Constant    `json:"else"`
 	L10n                  map[string]interface{} `json:"l10n"`
 	AdditionalInformation map[string]interface{} `json:"additional_information"`
 	logging               utils.Logger
+	nullAware             map[string]bool
+	target                TargetKey
+	document              map[string]interface{}
+	lookups               *validators.LookupCache
+}
+
+// Operation is an operator of the field in the order it was declared, the same operator can be used more than once
+type Operation struct {
+	Name     string
+	Constant Constant
 }
 
 type ConstantL10n struct {
@@ -78,7 +106,7 @@ func (s *Schematics) LoadJsonSchemaFile(path string) error {
 		return err
 	}
 	var schema Schema
-	err = json.Unmarshal(content, &schema)
+	err = utils.DecodeJSON(content, &schema)
 	if err != nil {
 		s.Logging.ERROR("Failed to unmarshall schema file", err)
 		return err
@@ -93,6 +121,10 @@ func (s *Schematics) LoadJsonSchemaFile(path string) error {
 	if s.Locale == "" {
 		s.Locale = "en"
 	}
+	if err := s.Prepare(); err != nil {
+		s.Logging.ERROR("Failed to prepare the schema", err)
+		return err
+	}
 	return nil
 }
 
@@ -103,7 +135,7 @@ func (s *Schematics) LoadMap(schemaMap interface{}) error {
 		return err
 	}
 	var schema Schema
-	err = json.Unmarshal(JSON, &schema)
+	err = utils.DecodeJSON(JSON, &schema)
 	if err != nil {
 		s.Logging.ERROR("Invalid Schema", err)
 		return err
@@ -118,17 +150,116 @@ func (s *Schematics) LoadMap(schemaMap interface{}) error {
 	if s.Locale == "" {
 		s.Locale = "en"
 	}
+	if err := s.Prepare(); err != nil {
+		s.Logging.ERROR("Failed to prepare the schema", err)
+		return err
+	}
+	return nil
+}
+
+// Prepare runs the preparers of the validators once, so that the attributes are checked when the schema is loaded
+func (s *Schematics) Prepare() error {
+	if err := s.Schema.AdditionalKeys.validate(); err != nil {
+		return err
+	}
+	for target, field := range s.Schema.Fields {
+		if field.Type != "" && !validators.IsKnownType(field.Type) {
+			return fmt.Errorf("%s: unknown type %s", target, field.Type)
+		}
+		if field.Format != "" && !validators.IsKnownFormat(field.Format) {
+			return fmt.Errorf("%s: unknown format %s", target, field.Format)
+		}
+		if err := checkDefaultGenerator(field.DefaultGenerator); err != nil {
+			return fmt.Errorf("%s: %v", target, err)
+		}
+		if err := checkRequiredMode(field.RequiredMode); err != nil {
+			return fmt.Errorf("%s: %v", target, err)
+		}
+		if err := field.Unique.validate(); err != nil {
+			return fmt.Errorf("%s: %v", target, err)
+		}
+		for name, constants := range field.Aggregates {
+			preparer, exists := s.Validators.AggregatePreparers[name]
+			if !exists {
+				continue
+			}
+			if constants.Attributes == nil {
+				constants.Attributes = make(map[string]interface{})
+				field.Aggregates[name] = constants
+			}
+			if err := preparer(constants.Attributes); err != nil {
+				return fmt.Errorf("%s: aggregate validator %s: %v", target, name, err)
+			}
+		}
+		for _, operation := range field.operations() {
+			preparer, exists := s.Operators.Preparers[operation.Name]
+			if !exists {
+				continue
+			}
+			if err := preparer(operation.Constant.Attributes); err != nil {
+				return fmt.Errorf("%s: operator %s: %v", target, operation.Name, err)
+			}
+		}
+		for _, components := range []map[string]Constant{field.Validators, field.Else} {
+			for name, constants := range components {
+				preparer, exists := s.Validators.Preparers[name]
+				if !exists {
+					continue
+				}
+				if constants.Attributes == nil {
+					constants.Attributes = make(map[string]interface{})
+					components[name] = constants
+				}
+				if err := preparer(constants.Attributes); err != nil {
+					return fmt.Errorf("%s: validator %s: %v", target, name, err)
+				}
+			}
+		}
+	}
 	return nil
 }
 
 // if validators >>> if passed then do *
 
 func (f *Field) Validate(value interface{}, allValidators map[string]validators.Validator, id *string, db map[string]interface{}) *errorHandler.Error {
+	return f.validateKey("", value, allValidators, id, db)
+}
+
+func (f *Field) validateKey(key string, value interface{}, allValidators map[string]validators.Validator, id *string, db map[string]interface{}) *errorHandler.Error {
 	var err errorHandler.Error
 	err.Value = value
-	err.ID = id
+	if id != nil {
+		err.ID = *id
+	}
 	err.Validator = "unknown"
+	// null is only passed to the null aware validators of nullable fields, without nullable it is like a missing key
+	isNull := value == nil && f.Type != validators.TypeNull
+	if isNull && f.Nullable == nil {
+		return nil
+	}
+	if isNull && !*f.Nullable {
+		err.Validator = "nullable"
+		err.AddMessage("en", "value can not be null")
+		return &err
+	}
+	if f.Type != "" && !isNull {
+		if typeError := validators.CheckType(f.Type, value); typeError != nil {
+			err.Validator = "type"
+			err.AddMessage("en", typeError.Error())
+			return &err
+		}
+	}
+	if f.Format != "" && !isNull {
+		if formatError := validators.CheckFormat(f.Format, value); formatError != nil {
+			err.Validator = "format"
+			err.AddMessage("en", formatError.Error())
+			return &err
+		}
+	}
 	if f.Validators == nil {
+		if f.Type != "" || f.Format != "" || isNull || f.Unique != nil || len(f.Aggregates) > 0 {
+			return nil
+		}
 		err.AddMessage("en", "no validators defined")
 		return &err
 	}
@@ -141,16 +272,12 @@ func (f *Field) Validate(value interface{}, allValidators map[string]validators.
 			err.AddMessage("en", "no validator name given")
 			return &err
 		}
-		if f.IsRequired && value == nil {
-			err.Validator = "Required"
-			err.AddMessage("en", fmt.Sprintf("missing required field"))
-			f.logging.DEBUG("Field is required but value is null")
-			return &err
-		}
-
 		if utils.StringInStrings(strings.ToUpper(name), utils.ExcludedValidators) {
 			continue
 		}
+		if isNull && !f.nullAware[name] {
+			continue
+		}
 
 		var fn validators.Validator
 		fn, exists := allValidators[name]
@@ -161,44 +288,61 @@ func (f *Field) Validate(value interface{}, allValidators map[string]validators.
 			return &err
 		}
 
-		if constants.Attributes == nil {
-			constants.Attributes = make(map[string]interface{})
-		}
-		constants.Attributes["DB"] = db
-		fnError := fn(value, constants.Attributes)
+		attributes := f.resolveAttributes(constants.Attributes, key)
+		attributes["DB"] = db
+		attributes["DOCUMENT"] = f.document
+		attributes[validators.LookupCacheKey] = f.lookups
+		fnError := fn(value, attributes)
 		f.logging.DEBUG("fnError: ", fnError)
 		if fnError != nil && fnError.Error() != "" {
-			err.AddMessage("en", fnError.Error())
-			if constants.Error != "" {
-				f.logging.DEBUG("Custom Error is Defined", constants.Error)
-				err.AddMessage("en", constants.Error)
-			}
+			f.addMessages(&err, name, constants, fnError)
+			return &err
+		}
+	}
+	return nil
+}
 
-			if f.L10n != nil {
-				for locale, msg := range constants.L10n.Error {
-					if msg != nil {
-						f.logging.DEBUG("Error L10n: ", locale, msg)
-						err.AddMessage(locale, msg.(string))
-					}
-				}
+// addMessages adds the error of the validator with its custom error and localizations
+func (f *Field) addMessages(err *errorHandler.Error, name string, constants Constant, fnError error) {
+	err.AddMessage("en", fnError.Error())
+	var validationError *validators.ValidationError
+	if errors.As(fnError, &validationError) {
+		err.Params = validationError.Params
+	}
+	if constants.Error != "" {
+		f.logging.DEBUG("Custom Error is Defined", constants.Error)
+		err.AddMessage("en", utils.FormatParams(constants.Error, err.Params))
+	}
 
-				for local, v := range constants.L10n.Name {
-					if v != nil {
-						f.logging.DEBUG("Validator L10n: ", local, v)
-						err.AddL10n(name, local, v.(string))
-					}
-				}
+	if f.L10n != nil {
+		for locale, msg := range constants.L10n.Error {
+			if msg != nil {
+				f.logging.DEBUG("Error L10n: ", locale, msg)
+				err.AddMessage(locale, utils.FormatParams(msg.(string), err.Params))
+			}
+		}
+
+		for local, v := range constants.L10n.Name {
+			if v != nil {
+				f.logging.DEBUG("Validator L10n: ", local, v)
+				err.AddL10n(name, local, v.(string))
 			}
-			return &err
 		}
 	}
-	return nil
 }
 
-func (s *Schematics) makeFlat(data map[string]interface{}) *map[string]interface{} {
+// otherwise returns the field with the else validators, which only run when the condition does not hold
+func (f Field) otherwise() Field {
+	f.Validators = f.Else
+	f.IsRequired = false
+	f.DependsOn = nil
+	return f
+}
+
+func (s *Schematics) makeFlat(data map[string]interface{}) *utils.DataMap {
 	var dMap utils.DataMap
 	dMap.FlattenTheMap(data, "", s.Separator)
-	return &dMap.Data
+	return &dMap
 }
 
 func (s *Schematics) deflate(data map[string]interface{}) map[string]interface{} {
@@ -224,9 +368,9 @@ func (s *Schematics) Validate(jsonData interface{}) *errorHandler.Errors {
 
 	var obj map[string]interface{}
 	var arr []map[string]interface{}
-	if err := json.Unmarshal(dataBytes, &obj); err == nil {
+	if err := utils.DecodeJSON(dataBytes, &obj); err == nil {
 		return s.ValidateObject(&obj, nil)
-	} else if err := json.Unmarshal(dataBytes, &arr); err == nil {
+	} else if err := utils.DecodeJSON(dataBytes, &arr); err == nil {
 		return s.ValidateArray(arr)
 	} else {
 		baseError.AddMessage("en", "invalid format provided for the data, can only be map[string]interface or []map[string]interface")
@@ -236,56 +380,124 @@ func (s *Schematics) Validate(jsonData interface{}) *errorHandler.Errors {
 }
 
 func (s *Schematics) ValidateObject(jsonData *map[string]interface{}, id *string) *errorHandler.Errors {
+	return s.validateObject(jsonData, id, newValidationRun())
+}
+
+// validationRun is shared by all the rows validated together
+type validationRun struct {
+	// rows keeps the unique values seen in the other rows of the array
+	rows    uniqueIndex
+	lookups *validators.LookupCache
+}
+
+func newValidationRun() *validationRun {
+	return &validationRun{rows: make(uniqueIndex), lookups: validators.NewLookupCache()}
+}
+
+func (s *Schematics) validateObject(jsonData *map[string]interface{}, id *string, run *validationRun) *errorHandler.Errors {
 	s.Logging.DEBUG("validating the object")
 	var errorMessages errorHandler.Errors
 	var baseError errorHandler.Error
-	flatData := *s.makeFlat(*jsonData)
+	dMap := s.makeFlat(*jsonData)
+	flatData := dMap.Data
+	if s.DefaultsBeforeValidation {
+		s.applyDefaults(flatData)
+	}
 	s.Logging.DEBUG("here after flat data --> ", flatData)
-	uniqueID := ""
-
+	// the id is kept as a string so the errors of different rows do not overwrite each other
+	var uniqueID interface{}
 	if id != nil {
 		uniqueID = *id
 	}
 	s.Logging.DEBUG("after unique id")
 
+	failedCoercion := make(map[string]bool)
+	if s.CoerceTypes {
+		failedCoercion = s.coerce(flatData, uniqueID, &errorMessages)
+	}
+	s.rejectAdditionalKeys(flatData, uniqueID, &errorMessages)
+
+	// from here on the arrays and objects can be targeted as well as the leaf values,
+	// they are built again when the defaults or the coercion changed the leaves
+	if s.DefaultsBeforeValidation || s.CoerceTypes {
+		dMap = s.makeFlat(s.deflate(flatData))
+	}
+	flatData = dMap.Nodes()
 	db := s.Schema.GetDB(flatData)
+	matches := make(uniqueIndex)
 
-	var missingFromDependants []string
 	for target, field := range s.Schema.Fields {
 		field.logging = s.Logging
-		baseError.Validator = "is-required"
+		field.nullAware = s.Validators.NullAware
+		field.target = target
+		field.document = flatData
+		field.lookups = run.lookups
+		if field.When != nil {
+			holds, err := field.When.Evaluate(flatData)
+			if err != nil {
+				s.Logging.ERROR("failed to evaluate the when condition", target, err)
+				baseError.Validator = "when"
+				baseError.AddMessage("en", err.Error())
+				errorMessages.AddError(string(target), baseError)
+				continue
+			}
+			if !holds {
+				if len(field.Else) == 0 {
+					continue
+				}
+				field = field.otherwise()
+			}
+		}
 		matchingKeys := utils.FindMatchingKeys(flatData, string(target))
 		s.Logging.DEBUG("matching keys --> ", matchingKeys)
-		if len(matchingKeys) == 0 {
-			if field.IsRequired {
-				baseError.AddMessage("en", fmt.Sprintf("field is required"))
-				errorMessages.AddError(string(target), baseError)
+		reported := make(map[string]bool)
+		if field.IsRequired {
+			for key, message := range s.missingKeys(flatData, &field, matchingKeys) {
+				var requiredError errorHandler.Error
+				requiredError.Validator = "is-required"
+				requiredError.Value = flatData[key]
+				requiredError.ID = uniqueID
+				requiredError.AddMessage("en", message)
+				errorMessages.AddError(key, requiredError)
+				reported[key] = true
+			}
+		}
+		if len(field.Aggregates) > 0 {
+			if aggregateError := field.aggregate(matchingKeys, s.Validators.AggregateFns, uniqueID, db, s.Separator); aggregateError != nil {
+				errorMessages.AddError(string(target), *aggregateError)
 			}
+		}
+		if len(matchingKeys) == 0 {
 			continue
 		}
 		s.Logging.DEBUG("after is required --> ", matchingKeys)
-		//	check for dependencies
-		if len(field.DependsOn) > 0 {
-			missing := false
-			for _, d := range field.DependsOn {
-				matchDependsOn := utils.FindMatchingKeys(flatData, d)
-				if !(utils.StringInStrings(string(target), missingFromDependants) == false && len(matchDependsOn) > 0) {
-					s.Logging.DEBUG("matched depends on", matchDependsOn)
-					baseError.Validator = "depends-on"
-					baseError.AddMessage("en", "this field depends on other values which do not exists")
-					errorMessages.AddError(string(target), baseError)
-					missingFromDependants = append(missingFromDependants, string(target))
-					missing = true
-					break
-				}
+		if field.Unique != nil {
+			index := matches
+			if field.Unique.Scope == UniqueScopeRows {
+				index = run.rows
 			}
-			if missing {
-				continue
+			for key, uniqueError := range field.duplicates(index, flatData, matchingKeys, uniqueID, s.Separator) {
+				errorMessages.AddError(key, uniqueError)
+				reported[key] = true
 			}
 		}
 
 		for key, value := range matchingKeys {
-			validationError := field.Validate(value, s.Validators.ValidationFns, &uniqueID, db)
+			if failedCoercion[key] || reported[key] {
+				continue
+			}
+			//	check for dependencies, relative to the same array element as the key
+			if dependency := field.missingDependency(flatData, key); dependency != "" {
+				s.Logging.DEBUG("missing dependency", key, dependency)
+				var dependencyError errorHandler.Error
+				dependencyError.Validator = "depends-on"
+				dependencyError.Value = value
+				dependencyError.ID = uniqueID
+				dependencyError.AddMessage("en", "this field depends on other values which do not exists")
+				errorMessages.AddError(key, dependencyError)
+				continue
+			}
+			validationError := field.validateKey(key, value, s.Validators.ValidationFns, id, db)
 			s.Logging.DEBUG(validationError)
 			if validationError != nil {
 				errorMessages.AddError(key, *validationError)
@@ -300,6 +512,18 @@ func (s *Schematics) ValidateObject(jsonData *map[string]interface{}, id *string
 	return nil
 }
 
+// missingDependency returns the first key from depends_on which does not exist in the data,
+// wildcards in depends_on are resolved to the array element of the key
+func (f *Field) missingDependency(flatData map[string]interface{}, key string) string {
+	for _, d := range f.DependsOn {
+		dependency := utils.ResolveRelativeKey(string(f.target), key, d)
+		if len(utils.FindMatchingKeys(flatData, dependency)) == 0 {
+			return dependency
+		}
+	}
+	return ""
+}
+
 // Corrected and completed GetDB function
 func (s *Schema) GetDB(flatData map[string]interface{}) map[string]interface{} {
 	db := s.DB
@@ -327,18 +551,18 @@ func (s *Schematics) ValidateArray(jsonData []map[string]interface{}) *errorHand
 	s.Logging.DEBUG("validating the array")
 	var errs errorHandler.Errors
 	i := 0
+	run := newValidationRun()
 	for _, d := range jsonData {
 		var errorMessages *errorHandler.Errors
 		var dMap utils.DataMap
 		dMap.FlattenTheMap(d, "", s.Separator)
 		arrayId, exists := dMap.Data[s.ArrayIdKey]
-		if !exists {
+		if !exists || arrayId == nil {
 			arrayId = fmt.Sprintf("row-%d", i)
-			exists = true
 		}
 
-		id := arrayId.(string)
-		errorMessages = s.ValidateObject(&d, &id)
+		id := fmt.Sprint(arrayId)
+		errorMessages = s.validateObject(&d, &id, run)
 		if errorMessages.HasErrors() {
 			s.Logging.ERROR("has errors", errorMessages.GetStrings("en", "%data\n"))
 			errs.MergeErrors(errorMessages)
@@ -355,20 +579,87 @@ func (s *Schematics) ValidateArray(jsonData []map[string]interface{}) *errorHand
 // operators
 
 func (f *Field) Operate(value interface{}, allOperations map[string]operators.Op) interface{} {
-	for operationName, operationConstants := range f.Operators {
-		customValidator, exists := allOperations[operationName]
+	for _, operation := range f.operations() {
+		customValidator, exists := allOperations[operation.Name]
 		if !exists {
-			f.logging.ERROR("This operation does not exists in basic or custom operators", operationName)
+			f.logging.ERROR("This operation does not exists in basic or custom operators", operation.Name)
 			return nil
 		}
-		result := customValidator(value, operationConstants.Attributes)
+		result := customValidator(value, operation.Constant.Attributes)
 		if result != nil {
-			value = result
+			value = *result
 		}
 	}
 	return value
 }
 
+// operations returns the operators in the declared order, the operators given only as a map run sorted by name
+func (f *Field) operations() []Operation {
+	if len(f.Operations) > 0 {
+		return f.Operations
+	}
+	names := make([]string, 0, len(f.Operators))
+	for name := range f.Operators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	operations := make([]Operation, 0, len(names))
+	for _, name := range names {
+		operations = append(operations, Operation{Name: name, Constant: f.Operators[name]})
+	}
+	return operations
+}
+
+// operate runs the operators of the field, on an error the value is returned as it was with the error,
+// the fail closed operators return the redaction token instead so the value they should hide is not kept
+func (f *Field) operate(value interface{}, ops *operators.Operators, id interface{}, db map[string]interface{}) (interface{}, *errorHandler.Error) {
+	original := value
+	var relocation *operators.Relocation
+	for _, operation := range f.operations() {
+		name := operation.Name
+		attributes := make(map[string]interface{}, len(operation.Constant.Attributes)+1)
+		for key, attribute := range operation.Constant.Attributes {
+			attributes[key] = attribute
+		}
+		attributes["DB"] = db
+		result, err := ops.Run(name, value, attributes)
+		if err != nil {
+			var operationError errorHandler.Error
+			operationError.Validator = name
+			operationError.Value = original
+			operationError.ID = id
+			operationError.AddMessage("en", err.Error())
+			if ops.FailClosed[name] {
+				operationError.Value = operators.RedactionToken
+				return operators.RedactionToken, &operationError
+			}
+			return original, &operationError
+		}
+		if result == operators.Dropped {
+			return result, nil
+		}
+		if moved, ok := result.(operators.Relocation); ok {
+			relocation = &moved
+			result = moved.Value
+		}
+		value = result
+	}
+	// the operators like Move go before the output key
+	if relocation == nil && f.OutputKey != "" {
+		relocation = &operators.Relocation{To: f.OutputKey}
+	}
+	if relocation != nil {
+		relocation.Value = value
+		return *relocation, nil
+	}
+	return value, nil
+}
+
+// operationDB is the DB of the schema with the DB of the schematics, which is passed to the operators
+func (s *Schematics) operationDB() map[string]interface{} {
+	return utils.CombineTwoMaps(utils.CombineTwoMaps(nil, s.Schema.DB), s.DB)
+}
+
 func (s *Schematics) Operate(data interface{}) (interface{}, *errorHandler.Errors) {
 	var errorMessages errorHandler.Errors
 	var baseError errorHandler.Error
@@ -391,8 +682,11 @@ func (s *Schematics) Operate(data interface{}) (interface{}, *errorHandler.Error
 
 	if dataType == "object" {
 		obj := item.(map[string]interface{})
-		results := s.OperateOnObject(obj)
+		results := s.operateOnObject(obj, nil, &errorMessages)
 		if results != nil {
+			if errorMessages.HasErrors() {
+				return results, &errorMessages
+			}
 			return results, nil
 		} else {
 			baseError.AddMessage("en", "operation on object unsuccessful")
@@ -401,8 +695,11 @@ func (s *Schematics) Operate(data interface{}) (interface{}, *errorHandler.Error
 		}
 	} else if dataType == "array" {
 		arr := item.([]map[string]interface{})
-		results := s.OperateOnArray(arr)
+		results := s.operateOnArray(arr, &errorMessages)
 		if results != nil && len(*results) > 0 {
+			if errorMessages.HasErrors() {
+				return results, &errorMessages
+			}
 			return results, nil
 		} else {
 			baseError.AddMessage("en", "operation on array unsuccessful")
@@ -414,22 +711,133 @@ func (s *Schematics) Operate(data interface{}) (interface{}, *errorHandler.Error
 	return data, nil
 }
 
+// OperateOnObject runs the operators on the object, the errors are logged, use OperateOnObjectWithErrors to get them
 func (s *Schematics) OperateOnObject(data map[string]interface{}) *map[string]interface{} {
-	data = *s.makeFlat(data)
+	result, errs := s.OperateOnObjectWithErrors(data)
+	if errs != nil {
+		s.Logging.ERROR("[operate] operators failed", errs.GetStrings("en", "%target: %message"))
+	}
+	return result
+}
+
+// OperateOnObjectWithErrors runs the operators on the object and returns the errors of the operators which failed
+func (s *Schematics) OperateOnObjectWithErrors(data map[string]interface{}) (*map[string]interface{}, *errorHandler.Errors) {
+	var errs errorHandler.Errors
+	result := s.operateOnObject(data, nil, &errs)
+	if errs.HasErrors() {
+		return result, &errs
+	}
+	return result, nil
+}
+
+func (s *Schematics) operateOnObject(data map[string]interface{}, id interface{}, errs *errorHandler.Errors) *map[string]interface{} {
+	dMap := s.makeFlat(data)
+	data = dMap.Data
+	s.stripAdditionalKeys(data)
+	s.applyDefaults(data)
+	failedCoercion := make(map[string]bool)
+	if s.CoerceTypes {
+		failedCoercion = s.coerce(data, id, errs)
+	}
+	containers := make(map[string]Field)
+	var relocations []relocation
+	db := s.operationDB()
 	for target, field := range s.Schema.Fields {
+		field.target = target
 		matchingKeys := utils.FindMatchingKeys(data, string(target))
 		for key, value := range matchingKeys {
-			data[key] = field.Operate(value, s.Operators.OpFunctions)
+			if failedCoercion[key] {
+				continue
+			}
+			result, err := field.operate(value, &s.Operators, id, db)
+			if err != nil {
+				errs.AddError(key, *err)
+			}
+			if result == operators.Dropped {
+				delete(data, key)
+				continue
+			}
+			if moved, ok := result.(operators.Relocation); ok {
+				relocations = append(relocations, s.relocationOf(target, key, moved))
+				continue
+			}
+			data[key] = result
+		}
+		if len(field.operations()) > 0 || field.OutputKey != "" {
+			for key := range utils.FindMatchingKeys(dMap.Containers, string(target)) {
+				containers[key] = field
+			}
 		}
 	}
+	s.relocate(data, relocations)
+	s.operateOnContainers(data, containers, id, db, errs)
 	d := s.deflate(data)
 	return &d
 }
 
+// operateOnContainers runs the operators on whole arrays and objects after the leaf values are done,
+// the deepest containers go first so their parents see the results
+func (s *Schematics) operateOnContainers(data map[string]interface{}, containers map[string]Field, id interface{}, db map[string]interface{}, errs *errorHandler.Errors) {
+	keys := make([]string, 0, len(containers))
+	for key := range containers {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		depthI, depthJ := strings.Count(keys[i], s.Separator), strings.Count(keys[j], s.Separator)
+		if depthI != depthJ {
+			return depthI > depthJ
+		}
+		return keys[i] < keys[j]
+	})
+	for _, key := range keys {
+		value, exists := utils.GetNestedValue(s.deflate(data), key, s.Separator)
+		if !exists {
+			continue
+		}
+		field := containers[key]
+		result, err := field.operate(value, &s.Operators, id, db)
+		if err != nil {
+			errs.AddError(key, *err)
+			if !s.Operators.FailClosed[err.Validator] {
+				continue
+			}
+		}
+		if moved, ok := result.(operators.Relocation); ok {
+			s.relocate(data, []relocation{s.relocationOf(field.target, key, moved)})
+			continue
+		}
+		utils.RemoveChildKeys(data, key, s.Separator)
+		if result == operators.Dropped {
+			delete(data, key)
+			continue
+		}
+		data[key] = result
+	}
+}
+
+// OperateOnArray runs the operators on every row, the errors are logged, use OperateOnArrayWithErrors to get them
 func (s *Schematics) OperateOnArray(data []map[string]interface{}) *[]map[string]interface{} {
+	result, errs := s.OperateOnArrayWithErrors(data)
+	if errs != nil {
+		s.Logging.ERROR("[operate] operators failed", errs.GetStrings("en", "%target: %message"))
+	}
+	return result
+}
+
+// OperateOnArrayWithErrors runs the operators on every row and returns the errors of the operators which failed
+func (s *Schematics) OperateOnArrayWithErrors(data []map[string]interface{}) (*[]map[string]interface{}, *errorHandler.Errors) {
+	var errs errorHandler.Errors
+	result := s.operateOnArray(data, &errs)
+	if errs.HasErrors() {
+		return result, &errs
+	}
+	return result, nil
+}
+
+func (s *Schematics) operateOnArray(data []map[string]interface{}, errs *errorHandler.Errors) *[]map[string]interface{} {
 	var obj []map[string]interface{}
-	for _, d := range data {
-		results := s.OperateOnObject(d)
+	for i, d := range data {
+		results := s.operateOnObject(d, fmt.Sprintf("row-%d", i), errs)
 		obj = append(obj, *results)
 	}
 	if len(obj) > 0 {
@@ -442,7 +850,7 @@ func (s *Schematics) OperateOnArray(data []map[string]interface{}) *[]map[string
 
 func (s *Schematics) MergeFields(sc2 *Schematics) *Schematics {
 	for target, field := range sc2.Schema.Fields {
-		if s.Schema.Fields[target].Type == "" {
+		if _, exists := s.Schema.Fields[target]; !exists {
 			s.Schema.Fields[target] = field
 		}
 	}
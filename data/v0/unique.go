@@ -0,0 +1,123 @@
+package v0
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/errorHandler"
+	"github.com/DScale-io/jsonschematics/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// UniqueScopeMatches compares the values of all the keys matching the target inside one object
+	UniqueScopeMatches = "matches"
+	// UniqueScopeRows compares the values across all the rows of ValidateArray
+	UniqueScopeRows = "rows"
+)
+
+// Unique makes the values of a field unique, With adds other keys to make a composite key,
+// wildcards in With are resolved to the same array element as the value
+type Unique struct {
+	Scope           string   `json:"scope"`
+	CaseInsensitive bool     `json:"case_insensitive"`
+	With            []string `json:"with"`
+}
+
+func (u *Unique) validate() error {
+	if u == nil {
+		return nil
+	}
+	switch u.Scope {
+	case "", UniqueScopeMatches, UniqueScopeRows:
+		return nil
+	}
+	return fmt.Errorf("unknown unique scope %s", u.Scope)
+}
+
+// uniqueIndex has the first location of every value for each target
+type uniqueIndex map[TargetKey]map[string]string
+
+// valueKey builds the comparable key of the value, null values are not compared
+func (f *Field) valueKey(flatData map[string]interface{}, key string, value interface{}) (string, bool) {
+	values := []interface{}{value}
+	for _, with := range f.Unique.With {
+		other, _ := utils.GetValueAt(flatData, utils.ResolveRelativeKey(string(f.target), key, with))
+		values = append(values, other)
+	}
+	for i, v := range values {
+		if v == nil {
+			return "", false
+		}
+		if number, ok := utils.ToRat(v); ok {
+			values[i] = map[string]string{"number": number.RatString()}
+		} else if str, ok := v.(string); ok && f.Unique.CaseInsensitive {
+			values[i] = strings.ToLower(str)
+		}
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
+
+// duplicates returns an error for every key whose value was already seen, referencing the first occurrence
+func (f *Field) duplicates(index uniqueIndex, flatData map[string]interface{}, matchingKeys map[string]interface{}, id interface{}, separator string) map[string]errorHandler.Error {
+	duplicates := make(map[string]errorHandler.Error)
+	if index[f.target] == nil {
+		index[f.target] = make(map[string]string)
+	}
+	seen := index[f.target]
+	for _, key := range sortKeys(matchingKeys, separator) {
+		valueKey, ok := f.valueKey(flatData, key, matchingKeys[key])
+		if !ok {
+			continue
+		}
+		location := key
+		if id != nil {
+			location = fmt.Sprintf("%v:%s", id, key)
+		}
+		first, exists := seen[valueKey]
+		if !exists {
+			seen[valueKey] = location
+			continue
+		}
+		var uniqueError errorHandler.Error
+		uniqueError.Validator = "unique"
+		uniqueError.Value = matchingKeys[key]
+		uniqueError.ID = id
+		uniqueError.AddMessage("en", fmt.Sprintf("value is a duplicate of %s", first))
+		duplicates[key] = uniqueError
+	}
+	return duplicates
+}
+
+// sortKeys sorts the keys in the order of the data, the array indexes are compared as numbers
+func sortKeys(data map[string]interface{}, separator string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return compareKeys(keys[i], keys[j], separator) < 0
+	})
+	return keys
+}
+
+func compareKeys(a string, b string, separator string) int {
+	partsA, partsB := strings.Split(a, separator), strings.Split(b, separator)
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		if partsA[i] == partsB[i] {
+			continue
+		}
+		indexA, errA := strconv.Atoi(partsA[i])
+		indexB, errB := strconv.Atoi(partsB[i])
+		if errA == nil && errB == nil {
+			return indexA - indexB
+		}
+		return strings.Compare(partsA[i], partsB[i])
+	}
+	return len(partsA) - len(partsB)
+}
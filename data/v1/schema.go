@@ -36,12 +36,15 @@ type Field struct {
 	TargetKey             string                 `json:"target_key"`
 	AddToDB               bool                   `json:"add_to_db"`
 	Type                  string                 `json:"type"`
+	Format                string                 `json:"format"`
 	IsRequired            bool                   `json:"required"`
 	Description           string                 `json:"description"`
 	Validators            map[string]Component   `json:"validators"`
 	Operators             map[string]Component   `json:"operators"`
 	L10n                  map[string]interface{} `json:"l10n"`
 	AdditionalInformation map[string]interface{} `json:"additional_information"`
+	Default               interface{}            `json:"default"`
+	DefaultGenerator      string                 `json:"default_generator"`
 }
 
 type ComponentLocal struct {
@@ -70,7 +73,7 @@ func (s *Schematics) Configs() {
 }
 
 func LoadJsonSchemaFile(path string) (*v0.Schematics, error) {
-	var s *Schematics
+	s := &Schematics{}
 	s.Configs()
 	content, err := os.ReadFile(path)
 	if err != nil {
@@ -78,7 +81,7 @@ func LoadJsonSchemaFile(path string) (*v0.Schematics, error) {
 		return nil, err
 	}
 	var schema Schema
-	err = json.Unmarshal(content, &schema)
+	err = utils.DecodeJSON(content, &schema)
 	if err != nil {
 		Logs.ERROR("Failed to unmarshall schema file", err)
 		return nil, err
@@ -89,7 +92,7 @@ func LoadJsonSchemaFile(path string) (*v0.Schematics, error) {
 }
 
 func LoadMap(schemaMap interface{}) (*v0.Schematics, error) {
-	var s *Schematics
+	s := &Schematics{}
 	s.Configs()
 	jsonBytes, err := json.Marshal(schemaMap)
 	if err != nil {
@@ -97,7 +100,7 @@ func LoadMap(schemaMap interface{}) (*v0.Schematics, error) {
 		return nil, err
 	}
 	var schema Schema
-	err = json.Unmarshal(jsonBytes, &schema)
+	err = utils.DecodeJSON(jsonBytes, &schema)
 	if err != nil {
 		Logs.ERROR("Failed to unmarshall schema file", err)
 		return nil, err
@@ -112,6 +115,9 @@ func transformSchematics(s Schematics) *v0.Schematics {
 	baseSchematics.Logging = s.Logging
 	baseSchematics.ArrayIdKey = s.ArrayIdKey
 	baseSchematics.Separator = s.Separator
+	if baseSchematics.Separator == "" {
+		baseSchematics.Separator = "."
+	}
 	baseSchematics.Validators = s.Validators
 	baseSchematics.Operators = s.Operators
 	baseSchematics.Validators.BasicValidators()
@@ -132,7 +138,8 @@ func transformSchema(schema Schema) *v0.Schema {
 		baseSchema.Fields[v0.TargetKey(field.TargetKey)] = v0.Field{
 			DependsOn:             field.DependsOn,
 			Name:                  field.Name,
-			Type:                  field.Name,
+			Type:                  field.Type,
+			Format:                field.Format,
 			AddToDB:               field.AddToDB,
 			IsRequired:            field.IsRequired,
 			Description:           field.Description,
@@ -140,6 +147,8 @@ func transformSchema(schema Schema) *v0.Schema {
 			Operators:             transformComponents(field.Operators),
 			L10n:                  field.L10n,
 			AdditionalInformation: field.AdditionalInformation,
+			Default:               field.Default,
+			DefaultGenerator:      field.DefaultGenerator,
 		}
 	}
 
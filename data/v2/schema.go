@@ -22,9 +22,10 @@ type Schematics struct {
 }
 
 type Schema struct {
-	Version string                 `json:"version"`
-	Fields  []Field                `json:"fields"`
-	DB      map[string]interface{} `json:"DB"`
+	Version        string                 `json:"version"`
+	Fields         []Field                `json:"fields"`
+	DB             map[string]interface{} `json:"DB"`
+	AdditionalKeys v0.AdditionalKeys      `json:"additional_keys"`
 }
 
 type Field struct {
@@ -34,10 +35,20 @@ type Field struct {
 	TargetKey             string                 `json:"target_key"`
 	AddToDB               bool                   `json:"add_to_db"`
 	Type                  string                 `json:"type"`
+	Format                string                 `json:"format"`
 	IsRequired            bool                   `json:"required"`
+	RequiredMode          string                 `json:"required_mode"`
+	Nullable              *bool                  `json:"nullable"`
+	Default               interface{}            `json:"default"`
+	DefaultGenerator      string                 `json:"default_generator"`
 	Description           string                 `json:"description"`
 	Validators            []Component            `json:"validators"`
+	Aggregates            []Component            `json:"aggregates"`
 	Operators             []Component            `json:"operators"`
+	OutputKey             string                 `json:"output_key"`
+	Unique                *v0.Unique             `json:"unique"`
+	When                  *v0.Condition          `json:"when"`
+	Else                  []Component            `json:"else"`
 	L10n                  map[string]interface{} `json:"l10n"`
 	AdditionalInformation map[string]interface{} `json:"additional_information"`
 }
@@ -61,13 +72,16 @@ func LoadJsonSchemaFile(path string) (*v0.Schematics, error) {
 		return nil, err
 	}
 	var schema Schema
-	err = json.Unmarshal(content, &schema)
+	err = utils.DecodeJSON(content, &schema)
 	if err != nil {
 		return nil, err
 	}
 	s.Schema = schema
 	baseSchematics := transformSchematics(s)
 	if baseSchematics != nil {
+		if err := baseSchematics.Prepare(); err != nil {
+			return nil, err
+		}
 		return baseSchematics, nil
 	} else {
 		return nil, errors.New("could not load the base schema")
@@ -81,12 +95,16 @@ func LoadMap(schemaMap interface{}) (*v0.Schematics, error) {
 		return nil, err
 	}
 	var schema Schema
-	err = json.Unmarshal(jsonBytes, &schema)
+	err = utils.DecodeJSON(jsonBytes, &schema)
 	if err != nil {
 		return nil, err
 	}
 	s.Schema = schema
-	return transformSchematics(s), nil
+	baseSchematics := transformSchematics(s)
+	if err := baseSchematics.Prepare(); err != nil {
+		return nil, err
+	}
+	return baseSchematics, nil
 }
 
 func transformSchematics(s Schematics) *v0.Schematics {
@@ -100,6 +118,12 @@ func transformSchematics(s Schematics) *v0.Schematics {
 
 	baseSchematics.ArrayIdKey = s.ArrayIdKey
 	baseSchematics.Separator = s.Separator
+	if baseSchematics.Separator == "" {
+		baseSchematics.Separator = "."
+	}
+	if baseSchematics.Locale == "" {
+		baseSchematics.Locale = "en"
+	}
 	baseSchematics.Validators.BasicValidators()
 	baseSchematics.Operators.LoadBasicOperations()
 	baseSchematics.Schema = *transformSchema(s.Schema)
@@ -113,6 +137,7 @@ func transformSchema(schema Schema) *v0.Schema {
 	var baseSchema v0.Schema
 	baseSchema.Version = schema.Version
 	baseSchema.DB = schema.DB
+	baseSchema.AdditionalKeys = schema.AdditionalKeys
 	baseSchema.Fields = make(map[v0.TargetKey]v0.Field)
 
 	for _, field := range schema.Fields {
@@ -120,11 +145,22 @@ func transformSchema(schema Schema) *v0.Schema {
 			DependsOn:             field.DependsOn,
 			Name:                  field.Name,
 			AddToDB:               field.AddToDB,
-			Type:                  field.Name,
+			Type:                  field.Type,
+			Format:                field.Format,
 			IsRequired:            field.IsRequired,
+			RequiredMode:          field.RequiredMode,
+			Nullable:              field.Nullable,
+			Default:               field.Default,
+			DefaultGenerator:      field.DefaultGenerator,
 			Description:           field.Description,
 			Validators:            transformComponents(field.Validators),
+			Aggregates:            transformComponents(field.Aggregates),
 			Operators:             transformComponents(field.Operators),
+			Operations:            transformOperations(field.Operators),
+			OutputKey:             field.OutputKey,
+			Unique:                field.Unique,
+			When:                  field.When,
+			Else:                  transformComponents(field.Else),
 			L10n:                  field.L10n,
 			AdditionalInformation: field.AdditionalInformation,
 		}
@@ -151,3 +187,19 @@ func transformComponents(comp []Component) map[string]v0.Constant {
 	}
 	return con
 }
+
+// transformOperations keeps the declared order of the operators, they run one after the other on the value
+func transformOperations(comp []Component) []v0.Operation {
+	operations := make([]v0.Operation, 0, len(comp))
+	for _, c := range comp {
+		operations = append(operations, v0.Operation{
+			Name: c.Name,
+			Constant: v0.Constant{
+				Attributes: c.Attributes,
+				Error:      c.Error,
+				L10n:       CreateConstantLocale(c.L10n),
+			},
+		})
+	}
+	return operations
+}
@@ -22,7 +22,9 @@ type Error struct {
 	L10n       ErrorL10n
 	Value      interface{}
 	ID         interface{}
-	Data       map[string]interface{}
+	// Params are the values used in the message, e.g. the min of a range
+	Params map[string]interface{}
+	Data   map[string]interface{}
 }
 
 type Errors struct {
@@ -73,6 +75,9 @@ func (e *Error) updateData(target string) Target {
 	e.Data["value"] = e.Value
 	e.Data["value"] = e.Value
 	e.Data["id"] = e.ID
+	if e.Params != nil {
+		e.Data["params"] = e.Params
+	}
 	return Target(t)
 }
 
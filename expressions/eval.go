@@ -0,0 +1,299 @@
+package expressions
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"math"
+	"strings"
+	"time"
+)
+
+// Env holds everything an expression can read, value is the value being validated,
+// $ reads from the flattened Document and DB reads from the schematics DB
+type Env struct {
+	Value    interface{}
+	Document map[string]interface{}
+	DB       map[string]interface{}
+	Now      func() time.Time
+}
+
+// Program is a compiled expression, it is safe to evaluate the same program concurrently
+type Program struct {
+	source string
+	root   node
+}
+
+// Compile parses the expression once so that it can be evaluated many times
+func Compile(source string) (*Program, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, syntaxError(0, "expression is empty")
+	}
+	if len(source) > maxExpressionLength {
+		return nil, syntaxError(maxExpressionLength, "expression is longer than %d characters", maxExpressionLength)
+	}
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, syntaxError(t.pos, "unexpected %s", describe(t))
+	}
+	return &Program{source: source, root: root}, nil
+}
+
+func (p *Program) String() string {
+	return p.source
+}
+
+// Eval evaluates the program, numbers are always returned as float64
+func (p *Program) Eval(env Env) (interface{}, error) {
+	if env.Now == nil {
+		env.Now = utils.Now
+	}
+	return evaluate(p.root, &env)
+}
+
+// EvalBool evaluates the program and expects the result to be a boolean
+func (p *Program) EvalBool(env Env) (bool, error) {
+	result, err := p.Eval(env)
+	if err != nil {
+		return false, err
+	}
+	b, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression should result in a boolean, got %v", result)
+	}
+	return b, nil
+}
+
+func normalize(value interface{}) interface{} {
+	if number, ok := utils.ToFloat64(value); ok {
+		return number
+	}
+	return value
+}
+
+func evaluate(n node, env *Env) (interface{}, error) {
+	switch n := n.(type) {
+	case *literalNode:
+		return n.value, nil
+	case *identNode:
+		if n.name == "value" {
+			return normalize(env.Value), nil
+		}
+		return env.DB, nil
+	case *documentNode:
+		segments := make([]string, 0, len(n.path))
+		for _, segment := range n.path {
+			key, err := evaluate(segment, env)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, keyToString(key))
+		}
+		if len(segments) == 0 {
+			return nil, errors.New("$ should be followed by a key")
+		}
+		value, _ := utils.GetValueAt(env.Document, strings.Join(segments, "."))
+		return normalize(value), nil
+	case *memberNode:
+		object, err := evaluate(n.object, env)
+		if err != nil {
+			return nil, err
+		}
+		key, err := evaluate(n.key, env)
+		if err != nil {
+			return nil, err
+		}
+		return member(object, key), nil
+	case *listNode:
+		items := make([]interface{}, 0, len(n.items))
+		for _, item := range n.items {
+			value, err := evaluate(item, env)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+		return items, nil
+	case *unaryNode:
+		operand, err := evaluate(n.operand, env)
+		if err != nil {
+			return nil, err
+		}
+		if n.operator == "!" {
+			b, ok := operand.(bool)
+			if !ok {
+				return nil, fmt.Errorf("'!' expects a boolean, got %v", operand)
+			}
+			return !b, nil
+		}
+		number, ok := operand.(float64)
+		if !ok {
+			return nil, fmt.Errorf("'-' expects a number, got %v", operand)
+		}
+		return -number, nil
+	case *binaryNode:
+		return evaluateBinary(n, env)
+	case *callNode:
+		args := make([]interface{}, 0, len(n.args))
+		for _, arg := range n.args {
+			value, err := evaluate(arg, env)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, value)
+		}
+		result, err := n.fn.call(env, args)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", n.name, err)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unknown expression node %T", n)
+}
+
+func keyToString(key interface{}) string {
+	if number, ok := key.(float64); ok && number == math.Trunc(number) {
+		return fmt.Sprintf("%d", int64(number))
+	}
+	return fmt.Sprint(key)
+}
+
+func member(object interface{}, key interface{}) interface{} {
+	switch o := object.(type) {
+	case map[string]interface{}:
+		return normalize(o[keyToString(key)])
+	case []interface{}:
+		index, ok := key.(float64)
+		if !ok {
+			if number, isNumber := utils.ToFloat64(key); isNumber {
+				index = number
+			} else if _, err := fmt.Sscan(fmt.Sprint(key), &index); err != nil {
+				return nil
+			}
+		}
+		if index < 0 || int(index) >= len(o) {
+			return nil
+		}
+		return normalize(o[int(index)])
+	}
+	return nil
+}
+
+func evaluateBinary(n *binaryNode, env *Env) (interface{}, error) {
+	left, err := evaluate(n.left, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.operator == "&&" || n.operator == "||" {
+		l, ok := left.(bool)
+		if !ok {
+			return nil, fmt.Errorf("'%s' expects booleans, got %v", n.operator, left)
+		}
+		if (n.operator == "&&" && !l) || (n.operator == "||" && l) {
+			return l, nil
+		}
+		right, err := evaluate(n.right, env)
+		if err != nil {
+			return nil, err
+		}
+		r, ok := right.(bool)
+		if !ok {
+			return nil, fmt.Errorf("'%s' expects booleans, got %v", n.operator, right)
+		}
+		return r, nil
+	}
+
+	right, err := evaluate(n.right, env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.operator {
+	case "==", "!=":
+		equal := utils.ValuesAreEqual(left, right)
+		if lt, ok := left.(time.Time); ok {
+			if rt, ok := right.(time.Time); ok {
+				equal = lt.Equal(rt)
+			}
+		}
+		return equal == (n.operator == "=="), nil
+	case "<", "<=", ">", ">=":
+		result, err := compare(left, right)
+		if err != nil {
+			return nil, err
+		}
+		switch n.operator {
+		case "<":
+			return result < 0, nil
+		case "<=":
+			return result <= 0, nil
+		case ">":
+			return result > 0, nil
+		}
+		return result >= 0, nil
+	case "+":
+		if ls, ok := left.(string); ok {
+			return ls + toString(right), nil
+		}
+		if rs, ok := right.(string); ok {
+			return toString(left) + rs, nil
+		}
+	}
+
+	l, lok := left.(float64)
+	r, rok := right.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("'%s' expects numbers, got %v and %v", n.operator, left, right)
+	}
+	switch n.operator {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return math.Mod(l, r), nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", n.operator)
+}
+
+func compare(left interface{}, right interface{}) (int, error) {
+	if lt, ok := left.(time.Time); ok {
+		if rt, ok := right.(time.Time); ok {
+			return lt.Compare(rt), nil
+		}
+	}
+	return utils.CompareValues(left, right)
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+			return fmt.Sprintf("%d", int64(v))
+		}
+	case time.Time:
+		return v.Format(time.RFC3339)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,312 @@
+package expressions
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type function struct {
+	minArgs int
+	maxArgs int
+	call    func(env *Env, args []interface{}) (interface{}, error)
+}
+
+var functions map[string]function
+
+func init() {
+	functions = map[string]function{
+		"len":         {1, 1, fnLen},
+		"lower":       {1, 1, stringFn(strings.ToLower)},
+		"upper":       {1, 1, stringFn(strings.ToUpper)},
+		"trim":        {1, 1, stringFn(strings.TrimSpace)},
+		"contains":    {2, 2, fnContains},
+		"startsWith":  {2, 2, twoStringsFn(strings.HasPrefix)},
+		"endsWith":    {2, 2, twoStringsFn(strings.HasSuffix)},
+		"matches":     {2, 2, fnMatches},
+		"abs":         {1, 1, numberFn(math.Abs)},
+		"floor":       {1, 1, numberFn(math.Floor)},
+		"ceil":        {1, 1, numberFn(math.Ceil)},
+		"round":       {1, 1, numberFn(math.Round)},
+		"min":         {1, -1, fnMin},
+		"max":         {1, -1, fnMax},
+		"sum":         {1, 1, fnSum},
+		"date":        {1, 2, fnDate},
+		"now":         {0, 0, fnNow},
+		"today":       {0, 0, fnToday},
+		"year":        {1, 1, dateFn(func(t time.Time) float64 { return float64(t.Year()) })},
+		"month":       {1, 1, dateFn(func(t time.Time) float64 { return float64(t.Month()) })},
+		"day":         {1, 1, dateFn(func(t time.Time) float64 { return float64(t.Day()) })},
+		"weekday":     {1, 1, dateFn(func(t time.Time) float64 { return float64(t.Weekday()) })},
+		"daysBetween": {2, 2, fnDaysBetween},
+		"addDays":     {2, 2, fnAddDays},
+		"age":         {1, 1, fnAge},
+	}
+}
+
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+func stringArg(args []interface{}, i int) (string, error) {
+	str, ok := args[i].(string)
+	if !ok {
+		return "", fmt.Errorf("argument %d should be a string, got %v", i+1, args[i])
+	}
+	return str, nil
+}
+
+func numberArg(args []interface{}, i int) (float64, error) {
+	number, ok := args[i].(float64)
+	if !ok {
+		return 0, fmt.Errorf("argument %d should be a number, got %v", i+1, args[i])
+	}
+	return number, nil
+}
+
+func dateArg(args []interface{}, i int) (time.Time, error) {
+	switch v := args[i].(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return parseDate(v, "")
+	}
+	return time.Time{}, fmt.Errorf("argument %d should be a date, got %v", i+1, args[i])
+}
+
+func parseDate(value string, layout string) (time.Time, error) {
+	if layout != "" {
+		return time.Parse(layout, value)
+	}
+	for _, l := range dateLayouts {
+		if t, err := time.Parse(l, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s is not a valid date", value)
+}
+
+func stringFn(fn func(string) string) func(*Env, []interface{}) (interface{}, error) {
+	return func(_ *Env, args []interface{}) (interface{}, error) {
+		str, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return fn(str), nil
+	}
+}
+
+func twoStringsFn(fn func(string, string) bool) func(*Env, []interface{}) (interface{}, error) {
+	return func(_ *Env, args []interface{}) (interface{}, error) {
+		a, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		b, err := stringArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return fn(a, b), nil
+	}
+}
+
+func numberFn(fn func(float64) float64) func(*Env, []interface{}) (interface{}, error) {
+	return func(_ *Env, args []interface{}) (interface{}, error) {
+		number, err := numberArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return fn(number), nil
+	}
+}
+
+func dateFn(fn func(time.Time) float64) func(*Env, []interface{}) (interface{}, error) {
+	return func(_ *Env, args []interface{}) (interface{}, error) {
+		date, err := dateArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return fn(date), nil
+	}
+}
+
+func fnLen(_ *Env, args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case string:
+		return float64(utf8.RuneCountInString(v)), nil
+	case []interface{}:
+		return float64(len(v)), nil
+	case map[string]interface{}:
+		return float64(len(v)), nil
+	case nil:
+		return float64(0), nil
+	}
+	return nil, fmt.Errorf("can not get the length of %v", args[0])
+}
+
+func fnContains(_ *Env, args []interface{}) (interface{}, error) {
+	if list, ok := args[0].([]interface{}); ok {
+		for _, item := range list {
+			if utils.ValuesAreEqual(item, args[1]) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	str, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := stringArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Contains(str, sub), nil
+}
+
+func fnMatches(_ *Env, args []interface{}) (interface{}, error) {
+	str, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := stringArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString(str), nil
+}
+
+func numbersOf(args []interface{}) ([]float64, error) {
+	if len(args) == 1 {
+		if list, ok := args[0].([]interface{}); ok {
+			args = list
+		}
+	}
+	numbers := make([]float64, 0, len(args))
+	for _, arg := range args {
+		number, ok := normalize(arg).(float64)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a number", arg)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+func fnMin(_ *Env, args []interface{}) (interface{}, error) {
+	numbers, err := numbersOf(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(numbers) == 0 {
+		return nil, errors.New("no numbers provided")
+	}
+	result := numbers[0]
+	for _, number := range numbers[1:] {
+		result = math.Min(result, number)
+	}
+	return result, nil
+}
+
+func fnMax(_ *Env, args []interface{}) (interface{}, error) {
+	numbers, err := numbersOf(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(numbers) == 0 {
+		return nil, errors.New("no numbers provided")
+	}
+	result := numbers[0]
+	for _, number := range numbers[1:] {
+		result = math.Max(result, number)
+	}
+	return result, nil
+}
+
+func fnSum(_ *Env, args []interface{}) (interface{}, error) {
+	if args[0] == nil {
+		return float64(0), nil
+	}
+	numbers, err := numbersOf(args)
+	if err != nil {
+		return nil, err
+	}
+	total := float64(0)
+	for _, number := range numbers {
+		total += number
+	}
+	return total, nil
+}
+
+func fnDate(_ *Env, args []interface{}) (interface{}, error) {
+	str, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	layout := ""
+	if len(args) > 1 {
+		if layout, err = stringArg(args, 1); err != nil {
+			return nil, err
+		}
+	}
+	return parseDate(str, layout)
+}
+
+func fnNow(env *Env, _ []interface{}) (interface{}, error) {
+	return env.Now(), nil
+}
+
+func fnToday(env *Env, _ []interface{}) (interface{}, error) {
+	now := env.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()), nil
+}
+
+func fnDaysBetween(_ *Env, args []interface{}) (interface{}, error) {
+	from, err := dateArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	to, err := dateArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return math.Floor(to.Sub(from).Hours() / 24), nil
+}
+
+func fnAddDays(_ *Env, args []interface{}) (interface{}, error) {
+	date, err := dateArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	days, err := numberArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return date.AddDate(0, 0, int(days)), nil
+}
+
+func fnAge(env *Env, args []interface{}) (interface{}, error) {
+	born, err := dateArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	now := env.Now()
+	years := now.Year() - born.Year()
+	if now.Month() < born.Month() || (now.Month() == born.Month() && now.Day() < born.Day()) {
+		years--
+	}
+	return float64(years), nil
+}
@@ -0,0 +1,118 @@
+package expressions
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenDollar
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+var operatorTokens = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"+", "-", "*", "/", "%", "<", ">", "!", "(", ")", "[", "]", ",", ".",
+}
+
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+	runes := []rune(source)
+	pos := 0
+	for pos < len(runes) {
+		r := runes[pos]
+		switch {
+		case unicode.IsSpace(r):
+			pos++
+		case unicode.IsDigit(r):
+			start := pos
+			for pos < len(runes) && unicode.IsDigit(runes[pos]) {
+				pos++
+			}
+			// after a '.' the digits are a path segment like the 0 of $.items.0.price, so the next '.' is not a fraction
+			afterDot := len(tokens) > 0 && tokens[len(tokens)-1].kind == tokenOperator && tokens[len(tokens)-1].text == "."
+			if !afterDot && pos+1 < len(runes) && runes[pos] == '.' && unicode.IsDigit(runes[pos+1]) {
+				pos++
+				for pos < len(runes) && unicode.IsDigit(runes[pos]) {
+					pos++
+				}
+			}
+			text := string(runes[start:pos])
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, syntaxError(start, "invalid number %s", text)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: number, pos: start})
+		case r == '"' || r == '\'':
+			start := pos
+			pos++
+			var sb strings.Builder
+			closed := false
+			for pos < len(runes) {
+				c := runes[pos]
+				if c == '\\' && pos+1 < len(runes) {
+					pos++
+					switch runes[pos] {
+					case 'n':
+						sb.WriteRune('\n')
+					case 't':
+						sb.WriteRune('\t')
+					default:
+						sb.WriteRune(runes[pos])
+					}
+					pos++
+					continue
+				}
+				if c == r {
+					closed = true
+					pos++
+					break
+				}
+				sb.WriteRune(c)
+				pos++
+			}
+			if !closed {
+				return nil, syntaxError(start, "unterminated string")
+			}
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[start:pos]), value: sb.String(), pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := pos
+			for pos < len(runes) && (unicode.IsLetter(runes[pos]) || unicode.IsDigit(runes[pos]) || runes[pos] == '_') {
+				pos++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:pos]), pos: start})
+		case r == '$':
+			tokens = append(tokens, token{kind: tokenDollar, text: "$", pos: pos})
+			pos++
+		default:
+			matched := false
+			for _, op := range operatorTokens {
+				if strings.HasPrefix(string(runes[pos:]), op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, pos: pos})
+					pos += len([]rune(op))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, syntaxError(pos, "unexpected character %q", r)
+			}
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes)})
+	return tokens, nil
+}
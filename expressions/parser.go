@@ -0,0 +1,290 @@
+package expressions
+
+import (
+	"fmt"
+)
+
+const (
+	maxExpressionLength = 4096
+	maxNestingDepth     = 64
+)
+
+// SyntaxError is returned by Compile when the expression can not be parsed
+type SyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at position %d: %s", e.Position, e.Message)
+}
+
+func syntaxError(pos int, format string, args ...interface{}) *SyntaxError {
+	return &SyntaxError{Position: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+type node interface{}
+
+type literalNode struct {
+	value interface{}
+}
+
+type identNode struct {
+	name string
+	pos  int
+}
+
+type documentNode struct {
+	path []node
+}
+
+type memberNode struct {
+	object node
+	key    node
+}
+
+type listNode struct {
+	items []node
+}
+
+type unaryNode struct {
+	operator string
+	operand  node
+	pos      int
+}
+
+type binaryNode struct {
+	operator string
+	left     node
+	right    node
+	pos      int
+}
+
+type callNode struct {
+	name string
+	fn   function
+	args []node
+	pos  int
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOperator(ops ...string) bool {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	t := p.next()
+	if t.kind != tokenOperator || t.text != op {
+		return syntaxError(t.pos, "expected '%s' but found %s", op, describe(t))
+	}
+	return nil
+}
+
+func describe(t token) string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("'%s'", t.text)
+}
+
+func (p *parser) parseExpression() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxNestingDepth {
+		return nil, syntaxError(p.peek().pos, "expression is nested too deeply")
+	}
+	return p.parseBinary(0)
+}
+
+// operator precedence, from the lowest to the highest
+var precedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) parseBinary(level int) (node, error) {
+	if level == len(precedence) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator(precedence[level]...) {
+		op := p.next()
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: op.text, left: left, right: right, pos: op.pos}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("!", "-") {
+		op := p.next()
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxNestingDepth {
+			return nil, syntaxError(op.pos, "expression is nested too deeply")
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{operator: op.text, operand: operand, pos: op.pos}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.isOperator("."):
+			p.next()
+			t := p.next()
+			if t.kind != tokenIdent && t.kind != tokenNumber {
+				return nil, syntaxError(t.pos, "expected a key after '.' but found %s", describe(t))
+			}
+			n = appendMember(n, &literalNode{value: t.text})
+		case p.isOperator("["):
+			p.next()
+			key, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = appendMember(n, key)
+		default:
+			return n, nil
+		}
+	}
+}
+
+func appendMember(object node, key node) node {
+	if doc, ok := object.(*documentNode); ok {
+		doc.path = append(doc.path, key)
+		return doc
+	}
+	return &memberNode{object: object, key: key}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber, tokenString:
+		return &literalNode{value: t.value}, nil
+	case tokenDollar:
+		return &documentNode{}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null", "nil":
+			return &literalNode{value: nil}, nil
+		}
+		if p.isOperator("(") {
+			return p.parseCall(t)
+		}
+		if t.text != "value" && t.text != "DB" {
+			return nil, syntaxError(t.pos, "unknown identifier '%s', use value, DB or $", t.text)
+		}
+		return &identNode{name: t.text, pos: t.pos}, nil
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			n, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return n, nil
+		case "[":
+			var list listNode
+			for !p.isOperator("]") {
+				item, err := p.parseExpression()
+				if err != nil {
+					return nil, err
+				}
+				list.items = append(list.items, item)
+				if !p.isOperator(",") {
+					break
+				}
+				p.next()
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			return &list, nil
+		}
+	}
+	return nil, syntaxError(t.pos, "unexpected %s", describe(t))
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	fn, exists := functions[name.text]
+	if !exists {
+		return nil, syntaxError(name.pos, "unknown function '%s'", name.text)
+	}
+	p.next()
+	call := &callNode{name: name.text, fn: fn, pos: name.pos}
+	for !p.isOperator(")") {
+		arg, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+		if !p.isOperator(",") {
+			break
+		}
+		p.next()
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if len(call.args) < fn.minArgs || (fn.maxArgs >= 0 && len(call.args) > fn.maxArgs) {
+		return nil, syntaxError(name.pos, "wrong number of arguments for '%s'", name.text)
+	}
+	return call, nil
+}
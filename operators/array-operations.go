@@ -1,7 +1,10 @@
 package operators
 
 func ArrayOfObjToObj(i interface{}, attr map[string]interface{}) *interface{} {
-	arr := i.([]interface{})
+	arr, ok := i.([]interface{})
+	if !ok {
+		return nil
+	}
 	uniqueValueKey, ok := attr["unique_string_key"].(string)
 	if !ok {
 		return nil
@@ -0,0 +1,205 @@
+package operators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"math/big"
+	"time"
+)
+
+var truncateUnits = map[string]bool{"year": true, "month": true, "day": true, "hour": true, "minute": true}
+
+// outputDate formats the date with the attribute 'output_layout', RFC 3339 by default
+func outputDate(date time.Time, attr map[string]interface{}) interface{} {
+	layout := time.RFC3339
+	if l, ok := attr["output_layout"].(string); ok && l != "" {
+		layout = utils.Layout(l)
+	}
+	return date.Format(layout)
+}
+
+func locationOf(attr map[string]interface{}, name string) (*time.Location, error) {
+	zone, err := stringAttribute(attr, name, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return utils.LoadTimezone(zone)
+}
+
+func epochUnit(attr map[string]interface{}) (time.Duration, error) {
+	unit, err := stringAttribute(attr, "unit", "", false)
+	if err != nil {
+		return 0, err
+	}
+	switch unit {
+	case "s", "seconds", "":
+		return time.Second, nil
+	case "ms", "milliseconds":
+		return time.Millisecond, nil
+	}
+	return 0, fmt.Errorf("unknown unit %s, it should be s or ms", unit)
+}
+
+// PrepareDate checks the attributes 'layout', 'timezone' and 'output_layout' which all the date operators have
+func PrepareDate(attr map[string]interface{}) error {
+	if err := PrepareStringsIfGiven("layout", "output_layout")(attr); err != nil {
+		return err
+	}
+	_, err := locationOf(attr, "timezone")
+	return err
+}
+
+func PrepareConvertTimezone(attr map[string]interface{}) error {
+	if err := PrepareDate(attr); err != nil {
+		return err
+	}
+	if _, err := stringAttribute(attr, "to", "", true); err != nil {
+		return err
+	}
+	_, err := locationOf(attr, "to")
+	return err
+}
+
+func PrepareTruncateDate(attr map[string]interface{}) error {
+	if err := PrepareDate(attr); err != nil {
+		return err
+	}
+	unit, err := stringAttribute(attr, "unit", "day", false)
+	if err != nil {
+		return err
+	}
+	if !truncateUnits[unit] {
+		return fmt.Errorf("unknown unit %s, it should be year, month, day, hour or minute", unit)
+	}
+	return nil
+}
+
+func PrepareShiftDate(attr map[string]interface{}) error {
+	if err := PrepareDate(attr); err != nil {
+		return err
+	}
+	duration, err := stringAttribute(attr, "duration", "", true)
+	if err != nil {
+		return err
+	}
+	if !utils.IsDuration(duration) {
+		return fmt.Errorf("%s is not a valid duration", duration)
+	}
+	return nil
+}
+
+func PrepareEpoch(attr map[string]interface{}) error {
+	if err := PrepareDate(attr); err != nil {
+		return err
+	}
+	_, err := epochUnit(attr)
+	return err
+}
+
+// FormatDate parses the date with the 'layout' and 'timezone' and writes it with the 'output_layout'
+func FormatDate(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	date, err := utils.ParseDateWithAttributes(i, attr)
+	if err != nil {
+		return nil, err
+	}
+	return outputDate(date, attr), nil
+}
+
+// ConvertTimezone moves the date to the timezone 'to'
+func ConvertTimezone(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	date, err := utils.ParseDateWithAttributes(i, attr)
+	if err != nil {
+		return nil, err
+	}
+	location, err := locationOf(attr, "to")
+	if err != nil {
+		return nil, err
+	}
+	return outputDate(date.In(location), attr), nil
+}
+
+// TruncateDate cuts the date to the start of the 'unit', which is one of year, month, day, hour or minute
+func TruncateDate(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	date, err := utils.ParseDateWithAttributes(i, attr)
+	if err != nil {
+		return nil, err
+	}
+	unit, _ := attr["unit"].(string)
+	switch unit {
+	case "year":
+		date = time.Date(date.Year(), 1, 1, 0, 0, 0, 0, date.Location())
+	case "month":
+		date = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	case "day", "":
+		date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	case "hour":
+		date = time.Date(date.Year(), date.Month(), date.Day(), date.Hour(), 0, 0, 0, date.Location())
+	case "minute":
+		date = time.Date(date.Year(), date.Month(), date.Day(), date.Hour(), date.Minute(), 0, 0, date.Location())
+	default:
+		return nil, fmt.Errorf("unknown unit %s, it should be year, month, day, hour or minute", unit)
+	}
+	return outputDate(date, attr), nil
+}
+
+// AddToDate adds the 'duration' like "30d" or "1y2M" to the date
+func AddToDate(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return shiftDate(i, attr, 1)
+}
+
+// SubtractFromDate subtracts the 'duration' like "30d" or "1h30m" from the date
+func SubtractFromDate(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return shiftDate(i, attr, -1)
+}
+
+func shiftDate(i interface{}, attr map[string]interface{}, direction int) (interface{}, error) {
+	date, err := utils.ParseDateWithAttributes(i, attr)
+	if err != nil {
+		return nil, err
+	}
+	duration, _ := attr["duration"].(string)
+	date, err = utils.ShiftDate(date, duration, direction)
+	if err != nil {
+		return nil, err
+	}
+	return outputDate(date, attr), nil
+}
+
+// ToUnix converts the date to the unix epoch in the 'unit', seconds (s) by default or milliseconds (ms)
+func ToUnix(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	date, err := utils.ParseDateWithAttributes(i, attr)
+	if err != nil {
+		return nil, err
+	}
+	unit, err := epochUnit(attr)
+	if err != nil {
+		return nil, err
+	}
+	if unit == time.Millisecond {
+		return date.UnixMilli(), nil
+	}
+	return date.Unix(), nil
+}
+
+// FromUnix converts the unix epoch in the 'unit' to a date in the 'timezone' written with the 'output_layout'
+func FromUnix(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	num, ok := utils.ToRat(i)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a number", i)
+	}
+	unit, err := epochUnit(attr)
+	if err != nil {
+		return nil, err
+	}
+	location, err := locationOf(attr, "timezone")
+	if err != nil {
+		return nil, err
+	}
+	scaled := new(big.Rat).Mul(num, new(big.Rat).SetInt64(int64(unit)))
+	nanos := new(big.Int).Quo(scaled.Num(), scaled.Denom())
+	if !nanos.IsInt64() {
+		return nil, errors.New("the epoch is out of the range of the dates")
+	}
+	return outputDate(time.Unix(0, nanos.Int64()).In(location), attr), nil
+}
@@ -1,29 +1,48 @@
 package operators
 
-func Add(i interface{}, attr map[string]interface{}) *interface{} {
-	num := i.(float64)
-	add := attr["add_with"].(float64)
-	var result interface{} = num + add
+import (
+	"github.com/DScale-io/jsonschematics/utils"
+	"math/big"
+)
+
+// calculate runs the operation on exact numbers, json numbers stay json numbers so large integers are not rounded
+func calculate(i interface{}, operand interface{}, operation func(*big.Rat, *big.Rat) *big.Rat) *interface{} {
+	num, ok := utils.ToRat(i)
+	if !ok {
+		return nil
+	}
+	with, ok := utils.ToRat(operand)
+	if !ok {
+		return nil
+	}
+	result := utils.NumberLike(i, operation(num, with))
 	return &result
 }
 
+func Add(i interface{}, attr map[string]interface{}) *interface{} {
+	return calculate(i, attr["add_with"], func(a *big.Rat, b *big.Rat) *big.Rat {
+		return new(big.Rat).Add(a, b)
+	})
+}
+
 func Subtract(i interface{}, attr map[string]interface{}) *interface{} {
-	num := i.(float64)
-	sub := attr["subtract_with"].(float64)
-	var result interface{} = num - sub
-	return &result
+	return calculate(i, attr["subtract_with"], func(a *big.Rat, b *big.Rat) *big.Rat {
+		return new(big.Rat).Sub(a, b)
+	})
 }
 
 func Multiply(i interface{}, attr map[string]interface{}) *interface{} {
-	num := i.(float64)
-	mul := attr["multiply_with"].(float64)
-	var result interface{} = num * mul
-	return &result
+	return calculate(i, attr["multiply_with"], func(a *big.Rat, b *big.Rat) *big.Rat {
+		return new(big.Rat).Mul(a, b)
+	})
 }
 
 func Divide(i interface{}, attr map[string]interface{}) *interface{} {
-	num := i.(float64)
-	divide := attr["divide_with"].(float64)
-	var result interface{} = num / divide
-	return &result
+	divide, ok := utils.ToRat(attr["divide_with"])
+	if !ok || divide.Sign() == 0 {
+		return nil
+	}
+	return calculate(i, divide, func(a *big.Rat, b *big.Rat) *big.Rat {
+		return new(big.Rat).Quo(a, b)
+	})
 }
@@ -1,27 +1,142 @@
 package operators
 
-import "github.com/DScale-io/jsonschematics/utils"
+import (
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+)
 
 type Operators struct {
 	OpFunctions map[string]Op
-	Logger      utils.Logger
+	Checked     map[string]CheckedOp
+	Preparers   map[string]Preparer
+	// FailClosed are the operations which replace the value with the RedactionToken when they fail,
+	// so the data they should hide is not passed on as it was
+	FailClosed map[string]bool
+	Logger     utils.Logger
 }
 
 type Op func(interface{}, map[string]interface{}) *interface{}
 
+// CheckedOp returns the error instead of leaving the value as it is, so it can be reported by Operate
+type CheckedOp func(interface{}, map[string]interface{}) (interface{}, error)
+
+// Preparer checks the attributes of an operation when the schema is loaded
+type Preparer func(map[string]interface{}) error
+
 func (op *Operators) RegisterOperation(name string, fn Op) {
 	op.Logger.DEBUG("registering operation:", name)
 	if op.OpFunctions == nil {
 		op.OpFunctions = make(map[string]Op)
 	}
 	op.OpFunctions[name] = fn
+	delete(op.Checked, name)
+	delete(op.FailClosed, name)
+}
+
+// RegisterCheckedOperation registers the operation which reports its errors, it is also added to the OpFunctions
+// where the value is left as it is on an error
+func (op *Operators) RegisterCheckedOperation(name string, fn CheckedOp) {
+	op.RegisterOperation(name, func(i interface{}, attributes map[string]interface{}) *interface{} {
+		result, err := fn(i, attributes)
+		if err != nil && !op.FailClosed[name] {
+			return nil
+		}
+		return &result
+	})
+	if op.Checked == nil {
+		op.Checked = make(map[string]CheckedOp)
+	}
+	op.Checked[name] = fn
+}
+
+// RegisterFailClosedOperation registers a checked operation which hides the value when it fails
+func (op *Operators) RegisterFailClosedOperation(name string, fn CheckedOp) {
+	op.RegisterCheckedOperation(name, func(i interface{}, attributes map[string]interface{}) (interface{}, error) {
+		result, err := fn(i, attributes)
+		if err != nil {
+			return RedactionToken, err
+		}
+		return result, nil
+	})
+	if op.FailClosed == nil {
+		op.FailClosed = make(map[string]bool)
+	}
+	op.FailClosed[name] = true
+}
+
+func (op *Operators) RegisterPreparer(name string, fn Preparer) {
+	if op.Preparers == nil {
+		op.Preparers = make(map[string]Preparer)
+	}
+	op.Preparers[name] = fn
+}
+
+// Run runs the operation by its name, the operations registered with RegisterOperation return the value as it is
+// when they have no result
+func (op *Operators) Run(name string, value interface{}, attributes map[string]interface{}) (interface{}, error) {
+	if checked, exists := op.Checked[name]; exists {
+		return checked(value, attributes)
+	}
+	fn, exists := op.OpFunctions[name]
+	if !exists {
+		return value, fmt.Errorf("operation %s does not exist", name)
+	}
+	if result := fn(value, attributes); result != nil {
+		return *result, nil
+	}
+	return value, nil
 }
 
 func (op *Operators) LoadBasicOperations() {
 	op.Logger.DEBUG("loading basic operations")
-	op.RegisterOperation("Capitalize", Capitalize)
-	op.RegisterOperation("UpperCase", UpperCase)
-	op.RegisterOperation("LowerCase", LowerCase)
+	op.RegisterCheckedOperation("Capitalize", Capitalize)
+	op.RegisterCheckedOperation("UpperCase", UpperCase)
+	op.RegisterCheckedOperation("LowerCase", LowerCase)
+	op.RegisterCheckedOperation("TitleCase", TitleCase)
+	op.RegisterCheckedOperation("Trim", Trim)
+	op.RegisterCheckedOperation("TrimPrefix", TrimPrefix)
+	op.RegisterPreparer("TrimPrefix", PrepareStrings("prefix"))
+	op.RegisterCheckedOperation("TrimSuffix", TrimSuffix)
+	op.RegisterPreparer("TrimSuffix", PrepareStrings("suffix"))
+	op.RegisterCheckedOperation("Replace", Replace)
+	op.RegisterPreparer("Replace", PrepareReplace)
+	op.RegisterCheckedOperation("RegexReplace", RegexReplace)
+	op.RegisterPreparer("RegexReplace", PrepareRegexReplace)
+	op.RegisterCheckedOperation("Truncate", Truncate)
+	op.RegisterPreparer("Truncate", PrepareTruncate)
+	op.RegisterCheckedOperation("PadLeft", PadLeft)
+	op.RegisterPreparer("PadLeft", PreparePad)
+	op.RegisterCheckedOperation("PadRight", PadRight)
+	op.RegisterPreparer("PadRight", PreparePad)
+	op.RegisterCheckedOperation("Slugify", Slugify)
+	op.RegisterCheckedOperation("Split", Split)
+	op.RegisterCheckedOperation("Join", Join)
+	op.RegisterCheckedOperation("CollapseWhitespace", CollapseWhitespace)
+
+	// privacy operations
+	op.RegisterFailClosedOperation("Mask", Mask)
+	op.RegisterPreparer("Mask", PrepareMask)
+	op.RegisterFailClosedOperation("MaskEmail", MaskEmail)
+	op.RegisterPreparer("MaskEmail", PrepareMask)
+	op.RegisterFailClosedOperation("MaskCardNumber", MaskCardNumber)
+	op.RegisterPreparer("MaskCardNumber", PrepareMask)
+	op.RegisterFailClosedOperation("MaskPhone", MaskPhone)
+	op.RegisterPreparer("MaskPhone", PrepareMask)
+	op.RegisterFailClosedOperation("Hash", Hash)
+	op.RegisterFailClosedOperation("HMAC", HMAC)
+	op.RegisterPreparer("HMAC", PrepareHMAC)
+	op.RegisterFailClosedOperation("Redact", Redact)
+	op.RegisterPreparer("Redact", PrepareStringsIfGiven("token"))
+	op.RegisterCheckedOperation("Drop", Drop)
+
+	// structure operations
+	op.RegisterCheckedOperation("Rename", Rename)
+	op.RegisterPreparer("Rename", PrepareRelocation)
+	op.RegisterCheckedOperation("Move", Move)
+	op.RegisterPreparer("Move", PrepareRelocation)
+	op.RegisterCheckedOperation("Copy", Copy)
+	op.RegisterPreparer("Copy", PrepareRelocation)
+	op.RegisterCheckedOperation("Delete", Delete)
 
 	// number operations
 	op.RegisterOperation("Add", Add)
@@ -29,6 +144,22 @@ func (op *Operators) LoadBasicOperations() {
 	op.RegisterOperation("Multiply", Multiply)
 	op.RegisterOperation("Divide", Divide)
 
+	// date operations
+	op.RegisterCheckedOperation("FormatDate", FormatDate)
+	op.RegisterPreparer("FormatDate", PrepareDate)
+	op.RegisterCheckedOperation("ConvertTimezone", ConvertTimezone)
+	op.RegisterPreparer("ConvertTimezone", PrepareConvertTimezone)
+	op.RegisterCheckedOperation("TruncateDate", TruncateDate)
+	op.RegisterPreparer("TruncateDate", PrepareTruncateDate)
+	op.RegisterCheckedOperation("AddToDate", AddToDate)
+	op.RegisterPreparer("AddToDate", PrepareShiftDate)
+	op.RegisterCheckedOperation("SubtractFromDate", SubtractFromDate)
+	op.RegisterPreparer("SubtractFromDate", PrepareShiftDate)
+	op.RegisterCheckedOperation("ToUnix", ToUnix)
+	op.RegisterPreparer("ToUnix", PrepareEpoch)
+	op.RegisterCheckedOperation("FromUnix", FromUnix)
+	op.RegisterPreparer("FromUnix", PrepareEpoch)
+
 	// arrays
 	op.RegisterOperation("ArrayOfObjToObj", ArrayOfObjToObj)
 
@@ -0,0 +1,228 @@
+package operators
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type dropped struct{}
+
+// Dropped is returned by the operations which remove the key from the data, like Drop
+var Dropped interface{} = dropped{}
+
+// RedactionToken replaces the values in Redact when no token is given
+const RedactionToken = "[REDACTED]"
+
+// privateStringOf is stringOf without the value in the error, the errors of the privacy operators must not show it
+func privateStringOf(i interface{}) (string, error) {
+	str, ok := i.(string)
+	if !ok {
+		return "", fmt.Errorf("value of type %T is not a string", i)
+	}
+	return str, nil
+}
+
+type maskOptions struct {
+	keepFirst int
+	keepLast  int
+	character string
+}
+
+func maskOptionsOf(attr map[string]interface{}, keepFirst int, keepLast int) (*maskOptions, error) {
+	options := maskOptions{keepFirst: keepFirst, keepLast: keepLast}
+	var err error
+	if _, exists := attr["keep_first"]; exists {
+		if options.keepFirst, err = intAttribute(attr, "keep_first"); err != nil {
+			return nil, err
+		}
+	}
+	if _, exists := attr["keep_last"]; exists {
+		if options.keepLast, err = intAttribute(attr, "keep_last"); err != nil {
+			return nil, err
+		}
+	}
+	if options.character, err = stringAttribute(attr, "character", "*", false); err != nil {
+		return nil, err
+	}
+	if utf8.RuneCountInString(options.character) != 1 {
+		return nil, errors.New("character attribute should be a single character")
+	}
+	return &options, nil
+}
+
+func PrepareMask(attr map[string]interface{}) error {
+	_, err := maskOptionsOf(attr, 0, 0)
+	return err
+}
+
+// mask hides the characters which maskable returns true for, except the first and the last ones to keep,
+// everything is hidden when there are not more characters than the ones to keep
+func (o *maskOptions) mask(str string, maskable func(rune) bool) string {
+	runes := []rune(str)
+	total := 0
+	for _, r := range runes {
+		if maskable(r) {
+			total++
+		}
+	}
+	keepFirst, keepLast := o.keepFirst, o.keepLast
+	if keepFirst+keepLast >= total {
+		keepFirst, keepLast = 0, 0
+	}
+	var builder strings.Builder
+	position := 0
+	for _, r := range runes {
+		if !maskable(r) {
+			builder.WriteRune(r)
+			continue
+		}
+		if position < keepFirst || position >= total-keepLast {
+			builder.WriteRune(r)
+		} else {
+			builder.WriteString(o.character)
+		}
+		position++
+	}
+	return builder.String()
+}
+
+func anyRune(rune) bool {
+	return true
+}
+
+// Mask hides the characters of the string except the 'keep_first' and the 'keep_last' ones with the 'character', "*" by default
+func Mask(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := privateStringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	options, err := maskOptionsOf(attr, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return options.mask(str, anyRune), nil
+}
+
+// MaskEmail hides the name of the email before @ except the 'keep_first' (1 by default) and the 'keep_last' characters,
+// with 'mask_domain' true the domain is hidden as well except the dots and its top level domain
+func MaskEmail(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := privateStringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	at := strings.LastIndex(str, "@")
+	if at < 1 || at == len(str)-1 {
+		return nil, errors.New("value is not an email")
+	}
+	options, err := maskOptionsOf(attr, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	name, domain := str[:at], str[at+1:]
+	if maskDomain, _ := attr["mask_domain"].(bool); maskDomain {
+		domainOptions := maskOptions{character: options.character}
+		notDot := func(r rune) bool { return r != '.' }
+		if dot := strings.LastIndex(domain, "."); dot > 0 {
+			domain = domainOptions.mask(domain[:dot], notDot) + domain[dot:]
+		} else {
+			domain = domainOptions.mask(domain, notDot)
+		}
+	}
+	return options.mask(name, anyRune) + "@" + domain, nil
+}
+
+// maskDigits checks the number is made of digits and the separators and hides the digits
+func maskDigits(i interface{}, attr map[string]interface{}, keepFirst int, keepLast int, separators string) (interface{}, error) {
+	str, err := privateStringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range str {
+		if !unicode.IsDigit(r) && !strings.ContainsRune(separators, r) {
+			return nil, errors.New("value is not a valid number")
+		}
+	}
+	options, err := maskOptionsOf(attr, keepFirst, keepLast)
+	if err != nil {
+		return nil, err
+	}
+	return options.mask(str, unicode.IsDigit), nil
+}
+
+// MaskCardNumber hides the digits of the card number except the last 4 by default, the spaces and the dashes are kept
+func MaskCardNumber(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return maskDigits(i, attr, 0, 4, " -")
+}
+
+// MaskPhone hides the digits of the phone number except the last 4 by default, "+", the spaces, the dashes,
+// the dots and the brackets are kept
+func MaskPhone(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return maskDigits(i, attr, 0, 4, "+ -.()")
+}
+
+// hashInput writes the strings and the numbers as they are so "42" and 42 give the same hash
+func hashInput(i interface{}) ([]byte, error) {
+	if str, ok := i.(string); ok {
+		return []byte(str), nil
+	}
+	if number, ok := utils.ToRat(i); ok {
+		return []byte(utils.FormatRat(number)), nil
+	}
+	return nil, fmt.Errorf("value of type %T can not be hashed, only strings and numbers can", i)
+}
+
+// Hash replaces the value with the hex of its SHA-256 hash
+func Hash(i interface{}, _ map[string]interface{}) (interface{}, error) {
+	input, err := hashInput(i)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(input)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func PrepareHMAC(attr map[string]interface{}) error {
+	name, err := stringAttribute(attr, "key", "", true)
+	if err == nil && name == "" {
+		err = errors.New("key attribute can not be empty")
+	}
+	return err
+}
+
+// HMAC replaces the value with the hex of its HMAC-SHA256, the secret is read from the DB by the name in 'key',
+// so the same value gives the same pseudonym without the secret being in the schema
+func HMAC(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	input, err := hashInput(i)
+	if err != nil {
+		return nil, err
+	}
+	name, err := stringAttribute(attr, "key", "", true)
+	if err != nil {
+		return nil, err
+	}
+	db, _ := attr["DB"].(map[string]interface{})
+	secret, _ := db[name].(string)
+	if secret == "" {
+		return nil, fmt.Errorf("key %s is not in the DB", name)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(input)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Redact replaces any value with the 'token', "[REDACTED]" by default
+func Redact(_ interface{}, attr map[string]interface{}) (interface{}, error) {
+	return stringAttribute(attr, "token", RedactionToken, false)
+}
+
+// Drop removes the key from the data
+func Drop(interface{}, map[string]interface{}) (interface{}, error) {
+	return Dropped, nil
+}
@@ -1,22 +1,452 @@
 package operators
 
 import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"regexp"
 	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 )
 
-func Capitalize(i interface{}, _ map[string]interface{}) *interface{} {
-	str := i.(string)
-	var opResult interface{} = strings.ToUpper(string(str[0])) + strings.ToLower(str[1:])
-	return &opResult
+func stringOf(i interface{}) (string, error) {
+	str, ok := i.(string)
+	if !ok {
+		return "", fmt.Errorf("%v is not a string", i)
+	}
+	return str, nil
 }
 
-func UpperCase(i interface{}, _ map[string]interface{}) *interface{} {
-	str := i.(string)
-	var opResult interface{} = strings.ToUpper(str)
-	return &opResult
+func stringAttribute(attr map[string]interface{}, name string, fallback string, required bool) (string, error) {
+	value, exists := attr[name]
+	if !exists {
+		if required {
+			return "", fmt.Errorf("%s attribute is required", name)
+		}
+		return fallback, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s attribute should be a string", name)
+	}
+	return str, nil
 }
-func LowerCase(i interface{}, _ map[string]interface{}) *interface{} {
-	str := i.(string)
-	var opResult interface{} = strings.ToLower(str)
-	return &opResult
+
+func intAttribute(attr map[string]interface{}, name string) (int, error) {
+	if !utils.IsWholeNumber(attr[name]) {
+		return 0, fmt.Errorf("%s attribute is required as a whole number", name)
+	}
+	number, _ := utils.ToFloat64(attr[name])
+	if number < 0 {
+		return 0, fmt.Errorf("%s attribute can not be negative", name)
+	}
+	return int(number), nil
+}
+
+// PrepareStrings checks the attributes are strings
+func PrepareStrings(names ...string) Preparer {
+	return func(attr map[string]interface{}) error {
+		for _, name := range names {
+			if _, err := stringAttribute(attr, name, "", true); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// PrepareStringsIfGiven checks the attributes are strings when they are given
+func PrepareStringsIfGiven(names ...string) Preparer {
+	return func(attr map[string]interface{}) error {
+		for _, name := range names {
+			if _, err := stringAttribute(attr, name, "", false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Capitalize writes the first letter in the upper case and the rest in the lower case
+func Capitalize(i interface{}, _ map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil || str == "" {
+		return str, err
+	}
+	first, size := utf8.DecodeRuneInString(str)
+	return string(unicode.ToUpper(first)) + strings.ToLower(str[size:]), nil
+}
+
+func UpperCase(i interface{}, _ map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	return strings.ToUpper(str), err
+}
+
+func LowerCase(i interface{}, _ map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	return strings.ToLower(str), err
+}
+
+// TitleCase writes the first letter of every word in the title case and the rest in the lower case
+func TitleCase(i interface{}, _ map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	var builder strings.Builder
+	inWord := false
+	for _, r := range str {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if inWord {
+				builder.WriteRune(unicode.ToLower(r))
+			} else {
+				builder.WriteRune(unicode.ToTitle(r))
+			}
+			inWord = true
+		case unicode.IsMark(r) || (inWord && (r == '\'' || r == '’')):
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune(r)
+			inWord = false
+		}
+	}
+	return builder.String(), nil
+}
+
+// Trim removes the white space around the string, or the 'characters' when they are given
+func Trim(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	characters, err := stringAttribute(attr, "characters", "", false)
+	if err != nil {
+		return nil, err
+	}
+	if characters == "" {
+		return strings.TrimSpace(str), nil
+	}
+	return strings.Trim(str, characters), nil
+}
+
+func TrimPrefix(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := stringAttribute(attr, "prefix", "", true)
+	if err != nil {
+		return nil, err
+	}
+	return strings.TrimPrefix(str, prefix), nil
+}
+
+func TrimSuffix(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	suffix, err := stringAttribute(attr, "suffix", "", true)
+	if err != nil {
+		return nil, err
+	}
+	return strings.TrimSuffix(str, suffix), nil
+}
+
+func PrepareReplace(attr map[string]interface{}) error {
+	old, err := stringAttribute(attr, "old", "", true)
+	if err != nil {
+		return err
+	}
+	if old == "" {
+		return errors.New("old attribute can not be empty")
+	}
+	if _, err := stringAttribute(attr, "new", "", false); err != nil {
+		return err
+	}
+	if _, exists := attr["count"]; exists {
+		_, err = intAttribute(attr, "count")
+	}
+	return err
+}
+
+// Replace replaces 'old' with 'new', all of them or the first 'count'
+func Replace(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	if err := PrepareReplace(attr); err != nil {
+		return nil, err
+	}
+	old, _ := stringAttribute(attr, "old", "", true)
+	replacement, _ := stringAttribute(attr, "new", "", false)
+	count := -1
+	if _, exists := attr["count"]; exists {
+		count, _ = intAttribute(attr, "count")
+	}
+	return strings.Replace(str, old, replacement, count), nil
+}
+
+var compiledPatterns sync.Map
+
+func patternOf(attr map[string]interface{}) (*regexp.Regexp, error) {
+	pattern, err := stringAttribute(attr, "pattern", "", true)
+	if err != nil {
+		return nil, err
+	}
+	if re, exists := compiledPatterns.Load(pattern); exists {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("pattern attribute is not a valid regex: %v", err)
+	}
+	compiledPatterns.Store(pattern, re)
+	return re, nil
+}
+
+func PrepareRegexReplace(attr map[string]interface{}) error {
+	if _, err := patternOf(attr); err != nil {
+		return err
+	}
+	_, err := stringAttribute(attr, "replacement", "", false)
+	return err
+}
+
+// RegexReplace replaces the matches of the 'pattern' with the 'replacement', which can use the groups like "$1"
+func RegexReplace(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	re, err := patternOf(attr)
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := stringAttribute(attr, "replacement", "", false)
+	if err != nil {
+		return nil, err
+	}
+	return re.ReplaceAllString(str, replacement), nil
+}
+
+// lengthMode reads the attribute 'mode' which tells how the length is counted, runes by default like the length validators
+func lengthMode(attr map[string]interface{}) (string, error) {
+	mode, err := stringAttribute(attr, "mode", utils.LengthRunes, false)
+	if err != nil {
+		return "", err
+	}
+	if _, err := utils.StringLength("", mode); err != nil {
+		return "", err
+	}
+	return mode, nil
+}
+
+func PrepareTruncate(attr map[string]interface{}) error {
+	length, err := intAttribute(attr, "length")
+	if err != nil {
+		return err
+	}
+	ellipsis, err := stringAttribute(attr, "ellipsis", "", false)
+	if err != nil {
+		return err
+	}
+	mode, err := lengthMode(attr)
+	if err != nil {
+		return err
+	}
+	if ellipsisLength, _ := utils.StringLength(ellipsis, mode); ellipsisLength > length {
+		return errors.New("ellipsis attribute can not be longer than the length")
+	}
+	return nil
+}
+
+// Truncate cuts the string to the 'length' counted in the 'mode', the 'ellipsis' e.g. "..." is counted in the length.
+// It cuts between the graphemes, so a combining mark, an emoji sequence or a flag is never split
+func Truncate(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	if err := PrepareTruncate(attr); err != nil {
+		return nil, err
+	}
+	length, _ := intAttribute(attr, "length")
+	ellipsis, _ := stringAttribute(attr, "ellipsis", "", false)
+	mode, _ := lengthMode(attr)
+	if current, _ := utils.StringLength(str, mode); current <= length {
+		return str, nil
+	}
+	ellipsisLength, _ := utils.StringLength(ellipsis, mode)
+	truncated, err := utils.TruncateString(str, length-ellipsisLength, mode)
+	if err != nil {
+		return nil, err
+	}
+	return truncated + ellipsis, nil
+}
+
+func PreparePad(attr map[string]interface{}) error {
+	if _, err := intAttribute(attr, "length"); err != nil {
+		return err
+	}
+	character, err := stringAttribute(attr, "character", " ", false)
+	if err != nil {
+		return err
+	}
+	if utils.GraphemeCount(character) != 1 {
+		return errors.New("character attribute should be a single character")
+	}
+	_, err = lengthMode(attr)
+	return err
+}
+
+// pad repeats the character as long as it fits in the missing length, counted in the 'mode'
+func pad(i interface{}, attr map[string]interface{}, left bool) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	if err := PreparePad(attr); err != nil {
+		return nil, err
+	}
+	length, _ := intAttribute(attr, "length")
+	character, _ := stringAttribute(attr, "character", " ", false)
+	mode, _ := lengthMode(attr)
+	current, _ := utils.StringLength(str, mode)
+	size, _ := utils.StringLength(character, mode)
+	count := (length - current) / size
+	if count <= 0 {
+		return str, nil
+	}
+	if left {
+		return strings.Repeat(character, count) + str, nil
+	}
+	return str + strings.Repeat(character, count), nil
+}
+
+// PadLeft fills the start of the string with the 'character', a space by default, up to the 'length' in the 'mode'
+func PadLeft(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return pad(i, attr, true)
+}
+
+// PadRight fills the end of the string with the 'character', a space by default, up to the 'length' in the 'mode'
+func PadRight(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return pad(i, attr, false)
+}
+
+// latinFolds are the latin letters written without their accents for the ascii slugs
+var latinFolds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ą': "a", 'ā': "a", 'æ': "ae",
+	'ç': "c", 'ć': "c", 'č': "c", 'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ę': "e", 'ě': "e", 'ē': "e", 'ğ': "g",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ı': "i", 'ī': "i", 'ł': "l", 'ľ': "l",
+	'ñ': "n", 'ń': "n", 'ň': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ő': "o", 'ō': "o", 'œ': "oe",
+	'ř': "r", 'ś': "s", 'š': "s", 'ş': "s", 'ß': "ss", 'ť': "t", 'ţ': "t", 'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ů': "u", 'ű': "u", 'ū': "u", 'ý': "y", 'ÿ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// Slugify writes the string in the lower case with the words joined by the 'separator', "-" by default,
+// the letters of all the languages are kept unless 'ascii' is true, then the latin accents are removed and
+// the other letters are dropped
+func Slugify(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	separator, err := stringAttribute(attr, "separator", "-", false)
+	if err != nil {
+		return nil, err
+	}
+	ascii, _ := attr["ascii"].(bool)
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(str) {
+		switch {
+		case r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			word.WriteRune(r)
+		case unicode.IsMark(r):
+			// accents written as combining marks
+		case ascii:
+			if folded, exists := latinFolds[r]; exists {
+				word.WriteString(folded)
+			} else if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				flush()
+			}
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return strings.Join(words, separator), nil
+}
+
+// Split splits the string by the 'separator', "," by default, with 'trim' true the parts are trimmed and the empty parts are dropped
+func Split(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	separator, err := stringAttribute(attr, "separator", ",", false)
+	if err != nil {
+		return nil, err
+	}
+	trim, _ := attr["trim"].(bool)
+	parts := make([]interface{}, 0)
+	for _, part := range strings.Split(str, separator) {
+		if trim {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
+
+// Join joins the strings and the numbers of the array with the 'separator', "," by default
+func Join(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	items, ok := i.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v is not an array", i)
+	}
+	separator, err := stringAttribute(attr, "separator", ",", false)
+	if err != nil {
+		return nil, err
+	}
+	parts := make([]string, len(items))
+	for index, item := range items {
+		switch v := item.(type) {
+		case string:
+			parts[index] = v
+		default:
+			number, ok := utils.ToRat(v)
+			if !ok {
+				return nil, fmt.Errorf("item %d is not a string or a number", index)
+			}
+			parts[index] = utils.FormatRat(number)
+		}
+	}
+	return strings.Join(parts, separator), nil
+}
+
+// CollapseWhitespace replaces every run of white space with a single space and trims the string
+func CollapseWhitespace(i interface{}, _ map[string]interface{}) (interface{}, error) {
+	str, err := stringOf(i)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Join(strings.Fields(str), " "), nil
 }
@@ -0,0 +1,53 @@
+package operators
+
+import (
+	"errors"
+)
+
+// Relocation is returned by the operations which put the value at another key, the key can have the wildcards
+// of the target e.g. "people.*.full_name" for "legacy.*.name" and they are replaced with the indices of the matched key
+type Relocation struct {
+	To string
+	// Rename replaces only the last part of the key with To
+	Rename bool
+	// Copy keeps the value at the original key as well
+	Copy  bool
+	Value interface{}
+}
+
+func PrepareRelocation(attr map[string]interface{}) error {
+	to, err := stringAttribute(attr, "to", "", true)
+	if err == nil && to == "" {
+		err = errors.New("to attribute can not be empty")
+	}
+	return err
+}
+
+func relocate(i interface{}, attr map[string]interface{}, relocation Relocation) (interface{}, error) {
+	if err := PrepareRelocation(attr); err != nil {
+		return nil, err
+	}
+	relocation.To, _ = stringAttribute(attr, "to", "", true)
+	relocation.Value = i
+	return relocation, nil
+}
+
+// Rename renames the key to 'to' in the same object, "name" with {"to": "full_name"} becomes "full_name"
+func Rename(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return relocate(i, attr, Relocation{Rename: true})
+}
+
+// Move moves the value to the key 'to'
+func Move(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return relocate(i, attr, Relocation{})
+}
+
+// Copy copies the value to the key 'to'
+func Copy(i interface{}, attr map[string]interface{}) (interface{}, error) {
+	return relocate(i, attr, Relocation{Copy: true})
+}
+
+// Delete removes the key from the data
+func Delete(interface{}, map[string]interface{}) (interface{}, error) {
+	return Dropped, nil
+}
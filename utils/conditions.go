@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+const (
+	OpEquals    = "equals"
+	OpNotEquals = "not_equals"
+	OpIn        = "in"
+	OpNotIn     = "not_in"
+	OpExists    = "exists"
+	OpNotExists = "not_exists"
+	OpMatches   = "matches"
+	OpGreater   = "gt"
+	OpGreaterEq = "gte"
+	OpLesser    = "lt"
+	OpLesserEq  = "lte"
+)
+
+func ToFloat64(i interface{}) (float64, bool) {
+	switch v := i.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// ValuesAreEqual compares two decoded json values, numbers are compared by their value and not by their go type
+func ValuesAreEqual(a interface{}, b interface{}) bool {
+	aNum, aIsNum := ToRat(a)
+	bNum, bIsNum := ToRat(b)
+	if aIsNum && bIsNum {
+		return aNum.Cmp(bNum) == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// CompareValues returns -1, 0 or 1, numbers are compared numerically and strings lexically
+func CompareValues(a interface{}, b interface{}) (int, error) {
+	aNum, aIsNum := ToRat(a)
+	bNum, bIsNum := ToRat(b)
+	if aIsNum && bIsNum {
+		return aNum.Cmp(bNum), nil
+	}
+	aStr, aIsStr := a.(string)
+	bStr, bIsStr := b.(string)
+	if aIsStr && bIsStr {
+		return strings.Compare(aStr, bStr), nil
+	}
+	return 0, fmt.Errorf("can not compare %v with %v", a, b)
+}
+
+// IsPredicateOperator tells if EvaluatePredicate knows the operator, an empty operator is equals
+func IsPredicateOperator(operator string) bool {
+	switch operator {
+	case "", OpEquals, OpNotEquals, OpIn, OpNotIn, OpExists, OpNotExists, OpMatches, OpGreater, OpGreaterEq, OpLesser, OpLesserEq:
+		return true
+	}
+	return false
+}
+
+// EvaluatePredicate checks the actual value against the expected value with the given operator
+func EvaluatePredicate(operator string, actual interface{}, expected interface{}) (bool, error) {
+	switch operator {
+	case OpEquals, "":
+		return ValuesAreEqual(actual, expected), nil
+	case OpNotEquals:
+		return !ValuesAreEqual(actual, expected), nil
+	case OpIn, OpNotIn:
+		options, ok := expected.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("value should be an array for the operator %s", operator)
+		}
+		found := false
+		for _, option := range options {
+			if ValuesAreEqual(actual, option) {
+				found = true
+				break
+			}
+		}
+		return found == (operator == OpIn), nil
+	case OpExists:
+		return true, nil
+	case OpNotExists:
+		return false, nil
+	case OpMatches:
+		pattern, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("value should be a regex string for the operator %s", operator)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(fmt.Sprint(actual)), nil
+	case OpGreater, OpGreaterEq, OpLesser, OpLesserEq:
+		result, err := CompareValues(actual, expected)
+		if err != nil {
+			return false, err
+		}
+		switch operator {
+		case OpGreater:
+			return result > 0, nil
+		case OpGreaterEq:
+			return result >= 0, nil
+		case OpLesser:
+			return result < 0, nil
+		default:
+			return result <= 0, nil
+		}
+	default:
+		return false, fmt.Errorf("unknown operator: %s", operator)
+	}
+}
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Now returns the current time for the date validators, tests can replace it to get the same results every time
+var Now = time.Now
+
+// DateLayouts are tried in order when no layout is given
+var DateLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.Layout,
+	time.ANSIC,
+	time.UnixDate,
+	time.RubyDate,
+	time.RFC822,
+	time.RFC822Z,
+	time.RFC850,
+	time.RFC1123,
+	time.RFC1123Z,
+	time.Kitchen,
+	time.Stamp,
+	time.StampMilli,
+	time.StampMicro,
+	time.StampNano,
+}
+
+// namedLayouts can be used in the layout attribute instead of the go layouts
+var namedLayouts = map[string]string{
+	"date":     "2006-01-02",
+	"datetime": time.RFC3339,
+	"time":     "15:04:05",
+	"rfc3339":  time.RFC3339,
+	"rfc1123":  time.RFC1123,
+}
+
+var relativeDateRegex = regexp.MustCompile(`^(now|today)((?:\s*[+-]\s*\d+\s*[yMwdhms])*)$`)
+var relativeTermRegex = regexp.MustCompile(`([+-]?)\s*(\d+)\s*([yMwdhms])`)
+var durationRegex = regexp.MustCompile(`^(?:\s*[+-]?\s*\d+\s*[yMwdhms])+\s*$`)
+
+// LoadTimezone loads the location by its IANA name, an empty name is UTC
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %s", name)
+	}
+	return location, nil
+}
+
+// Layout returns the go layout for the names like "date" and "rfc3339", other layouts are returned as they are
+func Layout(layout string) string {
+	if named, exists := namedLayouts[strings.ToLower(layout)]; exists {
+		return named
+	}
+	return layout
+}
+
+// ParseDateWithAttributes parses the value with the attributes 'layout' and 'timezone' of a validator or an operator
+func ParseDateWithAttributes(value interface{}, attributes map[string]interface{}) (time.Time, error) {
+	name, _ := attributes["timezone"].(string)
+	location, err := LoadTimezone(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	layout, _ := attributes["layout"].(string)
+	return ParseDate(value, layout, location)
+}
+
+// ParseDate parses the string with the layout, or with the DateLayouts when no layout is given,
+// dates without a zone are taken in the location
+func ParseDate(value interface{}, layout string, location *time.Location) (time.Time, error) {
+	str, ok := value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%v is not a date", value)
+	}
+	if location == nil {
+		location = time.UTC
+	}
+	if layout != "" {
+		layout = Layout(layout)
+		date, err := time.ParseInLocation(layout, str, location)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s is not a date in the layout %s", str, layout)
+		}
+		return date, nil
+	}
+	for _, l := range DateLayouts {
+		if date, err := time.ParseInLocation(l, str, location); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s is not a valid date", str)
+}
+
+// IsRelativeDate tells if the value is a date relative to now like "now-18y" or "today+30d"
+func IsRelativeDate(value string) bool {
+	return relativeDateRegex.MatchString(strings.TrimSpace(value))
+}
+
+// ResolveDate parses a date which can also be relative to now, "now-18y", "now+30d" and "today" are allowed,
+// the units are y (years), M (months), w (weeks), d (days), h (hours), m (minutes) and s (seconds)
+func ResolveDate(value interface{}, layout string, location *time.Location) (time.Time, error) {
+	str, ok := value.(string)
+	if !ok || !IsRelativeDate(str) {
+		return ParseDate(value, layout, location)
+	}
+	if location == nil {
+		location = time.UTC
+	}
+	match := relativeDateRegex.FindStringSubmatch(strings.TrimSpace(str))
+	date := Now().In(location)
+	if match[1] == "today" {
+		date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+	}
+	if match[2] == "" {
+		return date, nil
+	}
+	return ShiftDate(date, match[2], 1)
+}
+
+// IsDuration tells if the value is a duration like "30d", "-1y" or "1h30m"
+func IsDuration(value string) bool {
+	return durationRegex.MatchString(value)
+}
+
+// ShiftDate adds the duration to the date, or subtracts it when the direction is negative,
+// the duration is made of the same units as the relative dates e.g. "1y-2d"
+func ShiftDate(date time.Time, duration string, direction int) (time.Time, error) {
+	if !IsDuration(duration) {
+		return time.Time{}, fmt.Errorf("%s is not a valid duration", duration)
+	}
+	for _, term := range relativeTermRegex.FindAllStringSubmatch(duration, -1) {
+		amount, err := strconv.Atoi(term[2])
+		if err != nil {
+			return time.Time{}, err
+		}
+		if term[1] == "-" {
+			amount = -amount
+		}
+		if direction < 0 {
+			amount = -amount
+		}
+		switch term[3] {
+		case "y":
+			date = date.AddDate(amount, 0, 0)
+		case "M":
+			date = date.AddDate(0, amount, 0)
+		case "w":
+			date = date.AddDate(0, 0, 7*amount)
+		case "d":
+			date = date.AddDate(0, 0, amount)
+		case "h":
+			date = date.Add(time.Duration(amount) * time.Hour)
+		case "m":
+			date = date.Add(time.Duration(amount) * time.Minute)
+		case "s":
+			date = date.Add(time.Duration(amount) * time.Second)
+		}
+	}
+	return date, nil
+}
@@ -1,10 +1,13 @@
 package utils
 
 import (
+	"crypto/rand"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -16,34 +19,49 @@ var ExcludedValidators = []string{
 	"ISREQUIRED",
 }
 
+// DataMap holds the flattened data, Data has the leaf values (including null, empty arrays and objects)
+// and Containers has the non-empty arrays and objects, so they can be targeted as well
 type DataMap struct {
-	Data map[string]interface{}
+	Data       map[string]interface{}
+	Containers map[string]interface{}
 }
 
 func (d *DataMap) FlattenTheMap(data map[string]interface{}, prefix string, separator string) {
 	if d.Data == nil {
 		d.Data = make(map[string]interface{})
 	}
+	if d.Containers == nil {
+		d.Containers = make(map[string]interface{})
+	}
 	if separator == "" {
 		separator = "."
 	}
 	for key, value := range data {
-		if key != "" && value != nil {
+		if key != "" {
 			newKey := key
 			if prefix != "" {
 				newKey = prefix + separator + key
 			}
+			if value == nil {
+				d.Data[newKey] = nil
+				continue
+			}
 			switch reflect.TypeOf(value).Kind() {
 			case reflect.Map:
 				if nestedMap, ok := value.(map[string]interface{}); ok {
-					d.FlattenTheMap(nestedMap, newKey, separator)
+					d.flattenObject(nestedMap, newKey, separator)
 				}
 			case reflect.Slice:
 				s := reflect.ValueOf(value)
+				if s.Len() == 0 {
+					d.Data[newKey] = value
+					continue
+				}
+				d.Containers[newKey] = value
 				for i := 0; i < s.Len(); i++ {
 					arrayKey := newKey + separator + strconv.Itoa(i)
 					if nestedMap, ok := s.Index(i).Interface().(map[string]interface{}); ok {
-						d.FlattenTheMap(nestedMap, arrayKey, separator)
+						d.flattenObject(nestedMap, arrayKey, separator)
 					} else {
 						d.Data[arrayKey] = s.Index(i).Interface()
 					}
@@ -55,49 +73,111 @@ func (d *DataMap) FlattenTheMap(data map[string]interface{}, prefix string, sepa
 	}
 }
 
-func DeflateMap(data map[string]interface{}, separator string) map[string]interface{} {
-	result := make(map[string]interface{})
-
-	for flatKey, value := range data {
-		keys := strings.Split(flatKey, separator)
-		subMap := result
-
-		for i := 0; i < len(keys)-1; i++ {
-			key := keys[i]
-
-			if nextKeyIsIndex := i < len(keys)-1 && IsNumeric(keys[i+1]); nextKeyIsIndex {
-				if _, exists := subMap[key]; !exists {
-					subMap[key] = []interface{}{}
-				}
-
-				if reflect.TypeOf(subMap[key]).Kind() != reflect.Slice {
-					subMap[key] = []interface{}{}
-				}
-
-				slice := subMap[key].([]interface{})
-				index, _ := strconv.Atoi(keys[i+1])
-				for len(slice) <= index {
-					slice = append(slice, map[string]interface{}{})
-				}
-				subMap[key] = slice
+func (d *DataMap) flattenObject(object map[string]interface{}, key string, separator string) {
+	if len(object) == 0 {
+		d.Data[key] = object
+		return
+	}
+	d.Containers[key] = object
+	d.FlattenTheMap(object, key, separator)
+}
 
-				subMap = slice[index].(map[string]interface{})
-				i++
-			} else {
-				if _, exists := subMap[key]; !exists {
-					subMap[key] = map[string]interface{}{}
-				}
+// Nodes returns the leaves and the containers together
+func (d *DataMap) Nodes() map[string]interface{} {
+	nodes := make(map[string]interface{}, len(d.Data)+len(d.Containers))
+	for key, value := range d.Containers {
+		nodes[key] = value
+	}
+	for key, value := range d.Data {
+		nodes[key] = value
+	}
+	return nodes
+}
 
-				subMap = subMap[key].(map[string]interface{})
+// GetNestedValue walks the nested data through the maps and arrays to the key
+func GetNestedValue(data interface{}, key string, separator string) (interface{}, bool) {
+	current := data
+	for _, part := range strings.Split(key, separator) {
+		switch c := current.(type) {
+		case map[string]interface{}:
+			value, exists := c[part]
+			if !exists {
+				return nil, false
+			}
+			current = value
+		case []interface{}:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(c) {
+				return nil, false
 			}
+			current = c[index]
+		default:
+			return nil, false
 		}
+	}
+	return current, true
+}
 
-		subMap[keys[len(keys)-1]] = value
+// RemoveChildKeys removes all the keys which are inside the key from the flat data
+func RemoveChildKeys(data map[string]interface{}, key string, separator string) {
+	for k := range data {
+		if strings.HasPrefix(k, key+separator) {
+			delete(data, k)
+		}
 	}
+}
 
+func DeflateMap(data map[string]interface{}, separator string) map[string]interface{} {
+	result := make(map[string]interface{})
+	if separator == "" {
+		separator = "."
+	}
+	keys := make([]string, 0, len(data))
+	for flatKey := range data {
+		keys = append(keys, flatKey)
+	}
+	sort.Strings(keys)
+	for _, flatKey := range keys {
+		setNestedValue(result, strings.Split(flatKey, separator), data[flatKey])
+	}
 	return result
 }
 
+// setNestedValue sets the value at the path, creating arrays for the numeric keys and maps for the others
+func setNestedValue(container interface{}, path []string, value interface{}) interface{} {
+	if len(path) == 0 {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				return map[string]interface{}{}
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				return []interface{}{}
+			}
+		}
+		return value
+	}
+	key := path[0]
+	switch c := container.(type) {
+	case map[string]interface{}:
+		c[key] = setNestedValue(c[key], path[1:], value)
+		return c
+	case []interface{}:
+		if index, err := strconv.Atoi(key); err == nil && index >= 0 {
+			for len(c) <= index {
+				c = append(c, nil)
+			}
+			c[index] = setNestedValue(c[index], path[1:], value)
+			return c
+		}
+	}
+	if index, err := strconv.Atoi(key); err == nil && index >= 0 {
+		return setNestedValue(make([]interface{}, 0, index+1), path, value)
+	}
+	return setNestedValue(make(map[string]interface{}), path, value)
+}
+
 func IsNumeric(s string) bool {
 	_, err := strconv.Atoi(s)
 	return err == nil
@@ -164,11 +244,11 @@ func IsValidJson(content []byte) (string, interface{}) {
 	const IsArray = "array"
 	const IsObject = "object"
 
-	if err := json.Unmarshal(content, &arr); err == nil {
+	if err := DecodeJSON(content, &arr); err == nil {
 		return IsArray, arr
 	}
 
-	if err := json.Unmarshal(content, &obj); err == nil {
+	if err := DecodeJSON(content, &obj); err == nil {
 		return IsObject, obj
 	}
 	return "invalid format", nil
@@ -180,6 +260,14 @@ func GetPathRegex(path string) string {
 	return "^" + path + "$"
 }
 
+// FormatParams replaces the placeholders like {min} in the message with the values of the params
+func FormatParams(message string, params map[string]interface{}) string {
+	for name, value := range params {
+		message = strings.ReplaceAll(message, "{"+name+"}", fmt.Sprint(value))
+	}
+	return message
+}
+
 func FormatError(id *string, message string, target string, validator string, value string, format string, data *map[string]interface{}) string {
 	errorMessage := strings.Replace(format, "%message", message, -1)
 	errorMessage = strings.Replace(errorMessage, "%target", target, -1)
@@ -224,7 +312,7 @@ func BytesToMap(content []byte) (interface{}, error) {
 
 func getJsonFileAsMap(content []byte) (map[string]interface{}, error) {
 	var data map[string]interface{}
-	err := json.Unmarshal(content, &data)
+	err := DecodeJSON(content, &data)
 	if err != nil {
 		return nil, err
 	}
@@ -233,7 +321,7 @@ func getJsonFileAsMap(content []byte) (map[string]interface{}, error) {
 
 func getJsonFileAsMapArray(content []byte) ([]map[string]interface{}, error) {
 	var data []map[string]interface{}
-	err := json.Unmarshal(content, &data)
+	err := DecodeJSON(content, &data)
 	if err != nil {
 		return nil, err
 	}
@@ -251,3 +339,133 @@ func CombineTwoMaps(map1 map[string]interface{}, map2 map[string]interface{}) ma
 	}
 	return finalMap
 }
+
+// DeepCopy copies the maps and the arrays of the value, so the copy can be changed without changing the value
+func DeepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			copied[key] = DeepCopy(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = DeepCopy(item)
+		}
+		return copied
+	}
+	return value
+}
+
+// ResolveRelativeKey replaces the wildcards of the reference with the array indices that the matched key
+// has at the wildcards of the pattern, "items.*.start" for the pattern "items.*.end" and the key "items.2.end"
+// resolves to "items.2.start"
+func ResolveRelativeKey(pattern string, matchedKey string, reference string) string {
+	if !strings.Contains(pattern, "*") || !strings.Contains(reference, "*") {
+		return reference
+	}
+	capturing := "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, `(\d+)`) + "$"
+	re, err := regexp.Compile(capturing)
+	if err != nil {
+		return reference
+	}
+	indices := re.FindStringSubmatch(matchedKey)
+	if len(indices) < 2 {
+		return reference
+	}
+	for _, index := range indices[1:] {
+		if !strings.Contains(reference, "*") {
+			break
+		}
+		reference = strings.Replace(reference, "*", index, 1)
+	}
+	return reference
+}
+
+// GetValueAt returns the value of the key from the flat data, if the key is a pattern matching multiple keys
+// then all the matched values are returned
+func GetValueAt(flatData map[string]interface{}, key string) (interface{}, bool) {
+	if value, exists := flatData[key]; exists {
+		return value, true
+	}
+	matchingKeys := FindMatchingKeys(flatData, key)
+	switch len(matchingKeys) {
+	case 0:
+		return nil, false
+	case 1:
+		return GetFirstFromMap(matchingKeys), true
+	}
+	keys := make([]string, 0, len(matchingKeys))
+	for k := range matchingKeys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, matchingKeys[k])
+	}
+	return values, true
+}
+
+// ExpandWildcardKey returns the keys of the pattern for every array element existing in the flat data,
+// "user.addresses.*.tag" gives "user.addresses.0.tag" and "user.addresses.1.tag" when the data has two addresses
+func ExpandWildcardKey(data map[string]interface{}, pattern string, separator string) []string {
+	if separator == "" {
+		separator = "."
+	}
+	index := strings.Index(pattern, "*")
+	if index < 0 {
+		return []string{pattern}
+	}
+	prefix := pattern[:index]
+	rest := pattern[index+1:]
+	indices := make(map[int]bool)
+	for key := range data {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		segment := strings.SplitN(key[len(prefix):], separator, 2)[0]
+		if i, err := strconv.Atoi(segment); err == nil && i >= 0 {
+			indices[i] = true
+		}
+	}
+	sorted := make([]int, 0, len(indices))
+	for i := range indices {
+		sorted = append(sorted, i)
+	}
+	sort.Ints(sorted)
+	var keys []string
+	for _, i := range sorted {
+		keys = append(keys, ExpandWildcardKey(data, prefix+strconv.Itoa(i)+rest, separator)...)
+	}
+	return keys
+}
+
+// NewUUID generates a random (version 4) uuid
+func NewUUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// KeyExists checks if the key is in the flat data, either as a value or as the parent of other keys
+func KeyExists(data map[string]interface{}, key string, separator string) bool {
+	if _, exists := data[key]; exists {
+		return true
+	}
+	if separator == "" {
+		separator = "."
+	}
+	for k := range data {
+		if strings.HasPrefix(k, key+separator) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// DecodeJSON decodes the content keeping the numbers as json.Number, so large integers and decimals are not rounded
+func DecodeJSON(content []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("invalid character after the json value")
+	}
+	return nil
+}
+
+// ToRat converts a number to an exact rational, floats are taken by their shortest decimal form
+// so 0.1 from the schema is equal to 0.1 from the data
+func ToRat(i interface{}) (*big.Rat, bool) {
+	switch v := i.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(string(v))
+	case *big.Rat:
+		if v == nil {
+			return nil, false
+		}
+		return new(big.Rat).Set(v), true
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, false
+		}
+		return new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	case float32:
+		if math.IsInf(float64(v), 0) || math.IsNaN(float64(v)) {
+			return nil, false
+		}
+		return new(big.Rat).SetString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case int:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int8:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int16:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int32:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int64:
+		return new(big.Rat).SetInt64(v), true
+	case uint:
+		return new(big.Rat).SetUint64(uint64(v)), true
+	case uint8:
+		return new(big.Rat).SetUint64(uint64(v)), true
+	case uint16:
+		return new(big.Rat).SetUint64(uint64(v)), true
+	case uint32:
+		return new(big.Rat).SetUint64(uint64(v)), true
+	case uint64:
+		return new(big.Rat).SetUint64(v), true
+	}
+	return nil, false
+}
+
+// IsWholeNumber tells if the value is a number without a fraction, 42.0 is a whole number
+func IsWholeNumber(i interface{}) bool {
+	r, ok := ToRat(i)
+	return ok && r.IsInt()
+}
+
+// FormatRat writes the rational as a decimal, when it has no exact decimal form it is rounded to a float64
+func FormatRat(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	if places, exact := DecimalPlaces(r); exact {
+		return r.FloatString(places)
+	}
+	f, _ := r.Float64()
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// DecimalPlaces returns the number of decimals needed to write the rational, it can only be written
+// exactly when the denominator has no other factors than 2 and 5
+func DecimalPlaces(r *big.Rat) (int, bool) {
+	d := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	twos, fives := 0, 0
+	mod := new(big.Int)
+	for d.Cmp(big.NewInt(1)) > 0 {
+		if mod.Mod(d, two).Sign() == 0 {
+			d.Div(d, two)
+			twos++
+		} else if mod.Mod(d, five).Sign() == 0 {
+			d.Div(d, five)
+			fives++
+		} else {
+			return 0, false
+		}
+	}
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
+
+// NumberLike returns the result in the same kind as the original value, json.Number stays exact
+// and the go numbers are returned as float64
+func NumberLike(original interface{}, r *big.Rat) interface{} {
+	if _, ok := original.(json.Number); ok {
+		return json.Number(FormatRat(r))
+	}
+	f, _ := r.Float64()
+	return f
+}
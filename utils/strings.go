@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	LengthBytes     = "bytes"
+	LengthRunes     = "runes"
+	LengthGraphemes = "graphemes"
+)
+
+// StringLength counts the string in the mode, runes by default so "محمد" is 4 and not 8 as its bytes
+func StringLength(str string, mode string) (int, error) {
+	switch mode {
+	case LengthBytes:
+		return len(str), nil
+	case LengthRunes, "":
+		return utf8.RuneCountInString(str), nil
+	case LengthGraphemes:
+		return GraphemeCount(str), nil
+	}
+	return 0, fmt.Errorf("unknown length mode %s, it should be bytes, runes or graphemes", mode)
+}
+
+// GraphemeCount counts the user perceived characters, the combining marks, the emoji sequences joined
+// with a zero width joiner, the flags and the hangul syllables are counted once. It follows the main rules
+// of the extended grapheme clusters of unicode (UAX #29) without the tables of the full standard.
+func GraphemeCount(str string) int {
+	return len(Graphemes(str))
+}
+
+// Graphemes splits the string into the user perceived characters counted by GraphemeCount,
+// cutting a string between them never breaks a character
+func Graphemes(str string) []string {
+	var graphemes []string
+	start := 0
+	var previous rune = -1
+	// emoji is set while the cluster is an emoji followed by extends, joined when it is followed by a joiner
+	emoji, joined := false, false
+	regionalIndicators := 0
+	for i, r := range str {
+		if previous < 0 || isGraphemeBreak(previous, r, joined, regionalIndicators) {
+			if previous >= 0 {
+				graphemes = append(graphemes, str[start:i])
+			}
+			start = i
+			emoji = false
+		}
+		if isPictographic(r) {
+			emoji = true
+		} else if !isGraphemeExtend(r) && r != zeroWidthJoiner {
+			emoji = false
+		}
+		joined = emoji && r == zeroWidthJoiner
+		if isRegionalIndicator(r) {
+			regionalIndicators++
+		} else {
+			regionalIndicators = 0
+		}
+		previous = r
+	}
+	if previous >= 0 {
+		graphemes = append(graphemes, str[start:])
+	}
+	return graphemes
+}
+
+// TruncateString cuts the string to at most the length counted in the mode like StringLength,
+// it only cuts between the graphemes so a combining mark, an emoji sequence or a flag is never split
+func TruncateString(str string, length int, mode string) (string, error) {
+	total := 0
+	end := 0
+	for _, grapheme := range Graphemes(str) {
+		size, err := StringLength(grapheme, mode)
+		if err != nil {
+			return "", err
+		}
+		if total+size > length {
+			break
+		}
+		total += size
+		end += len(grapheme)
+	}
+	return str[:end], nil
+}
+
+const (
+	zeroWidthJoiner    = '\u200D'
+	zeroWidthNonJoiner = '\u200C'
+)
+
+func isGraphemeBreak(previous rune, r rune, joined bool, regionalIndicators int) bool {
+	switch {
+	case previous == '\r' && r == '\n':
+		return false
+	case isGraphemeControl(previous) || isGraphemeControl(r):
+		return true
+	case hangulJoins(previous, r):
+		return false
+	case isGraphemeExtend(r) || r == zeroWidthJoiner:
+		return false
+	case joined && isPictographic(r):
+		return false
+	case isRegionalIndicator(previous) && isRegionalIndicator(r):
+		return regionalIndicators%2 == 0
+	}
+	return true
+}
+
+func isGraphemeControl(r rune) bool {
+	return r == '\r' || r == '\n' || unicode.IsControl(r) || (unicode.Is(unicode.Cf, r) && r != zeroWidthJoiner && r != zeroWidthNonJoiner && !isTag(r))
+}
+
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) || r == zeroWidthNonJoiner || isEmojiModifier(r) || isTag(r)
+}
+
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+func isTag(r rune) bool {
+	return r >= 0xE0020 && r <= 0xE007F
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isPictographic approximates the extended pictographic property with the blocks of the emoji
+func isPictographic(r rune) bool {
+	return r == 0x00A9 || r == 0x00AE || (r >= 0x2190 && r <= 0x21FF) || (r >= 0x2300 && r <= 0x23FF) ||
+		(r >= 0x2600 && r <= 0x27BF) || (r >= 0x2B00 && r <= 0x2BFF) || (r >= 0x1F000 && r <= 0x1FAFF)
+}
+
+func hangulJoins(previous rune, r rune) bool {
+	isL := func(r rune) bool { return (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C) }
+	isV := func(r rune) bool { return (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6) }
+	isT := func(r rune) bool { return (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB) }
+	isSyllable := r >= 0xAC00 && r <= 0xD7A3
+	previousSyllable := previous >= 0xAC00 && previous <= 0xD7A3
+	isLV := previousSyllable && (previous-0xAC00)%28 == 0
+	switch {
+	case isL(previous):
+		return isL(r) || isV(r) || isSyllable
+	case isLV || isV(previous):
+		return isV(r) || isT(r)
+	case previousSyllable || isT(previous):
+		return isT(r)
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"math/big"
+	"regexp"
+)
+
+// AggregateValidator gets all the values matching a wildcard target at once,
+// the values are in the order of the keys in the data
+type AggregateValidator func([]interface{}, map[string]interface{}) error
+
+func numbers(values []interface{}) ([]*big.Rat, error) {
+	var result []*big.Rat
+	for _, value := range values {
+		number, ok := utils.ToRat(value)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a number", value)
+		}
+		result = append(result, number)
+	}
+	return result, nil
+}
+
+// prepareOperator checks the attribute 'operator' is known and the 'value' fits it
+func prepareOperator(attr map[string]interface{}) error {
+	operator, exists := attr["operator"]
+	if !exists {
+		return nil
+	}
+	name, ok := operator.(string)
+	if !ok || !utils.IsPredicateOperator(name) {
+		return fmt.Errorf("unknown operator %v", operator)
+	}
+	switch name {
+	case utils.OpIn, utils.OpNotIn:
+		if _, ok := attr["value"].([]interface{}); !ok {
+			return fmt.Errorf("attribute 'value' should be an array for the operator %s", name)
+		}
+	case utils.OpMatches:
+		pattern, ok := attr["value"].(string)
+		if !ok {
+			return fmt.Errorf("attribute 'value' should be a regex string for the operator %s", name)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrepareAggregate checks the attributes of Sum, Count, Min, Max and Avg, which compare their result with the 'value'
+func PrepareAggregate(attr map[string]interface{}) error {
+	if _, exists := attr["value"]; !exists {
+		return errors.New("attribute 'value' is required")
+	}
+	return prepareOperator(attr)
+}
+
+// PrepareMatching checks the attributes of Any, All and None
+func PrepareMatching(attr map[string]interface{}) error {
+	return prepareOperator(attr)
+}
+
+// checkAggregate compares the aggregated value with the attributes 'operator' and 'value'
+func checkAggregate(name string, actual *big.Rat, attr map[string]interface{}) error {
+	expected, exists := attr["value"]
+	if !exists {
+		return errors.New("attribute 'value' is required")
+	}
+	operator, _ := attr["operator"].(string)
+	if operator == "" {
+		operator = utils.OpEquals
+	}
+	holds, err := utils.EvaluatePredicate(operator, actual, expected)
+	if err != nil {
+		return err
+	}
+	if !holds {
+		return fmt.Errorf("%s of the values is %s, expected %s %v", name, utils.FormatRat(actual), operator, expected)
+	}
+	return nil
+}
+
+func sum(numbers []*big.Rat) *big.Rat {
+	total := new(big.Rat)
+	for _, number := range numbers {
+		total.Add(total, number)
+	}
+	return total
+}
+
+func Sum(values []interface{}, attr map[string]interface{}) error {
+	numbers, err := numbers(values)
+	if err != nil {
+		return err
+	}
+	return checkAggregate("sum", sum(numbers), attr)
+}
+
+func Count(values []interface{}, attr map[string]interface{}) error {
+	return checkAggregate("count", new(big.Rat).SetInt64(int64(len(values))), attr)
+}
+
+func Min(values []interface{}, attr map[string]interface{}) error {
+	numbers, err := numbers(values)
+	if err != nil || len(numbers) == 0 {
+		return err
+	}
+	min := numbers[0]
+	for _, number := range numbers[1:] {
+		if number.Cmp(min) < 0 {
+			min = number
+		}
+	}
+	return checkAggregate("min", min, attr)
+}
+
+func Max(values []interface{}, attr map[string]interface{}) error {
+	numbers, err := numbers(values)
+	if err != nil || len(numbers) == 0 {
+		return err
+	}
+	max := numbers[0]
+	for _, number := range numbers[1:] {
+		if number.Cmp(max) > 0 {
+			max = number
+		}
+	}
+	return checkAggregate("max", max, attr)
+}
+
+func Avg(values []interface{}, attr map[string]interface{}) error {
+	numbers, err := numbers(values)
+	if err != nil || len(numbers) == 0 {
+		return err
+	}
+	average := sum(numbers)
+	average.Quo(average, new(big.Rat).SetInt64(int64(len(numbers))))
+	return checkAggregate("avg", average, attr)
+}
+
+// countMatching counts the values satisfying 'operator' and 'value', without them the values have to be true
+func countMatching(values []interface{}, attr map[string]interface{}) (int, error) {
+	operator, _ := attr["operator"].(string)
+	expected, exists := attr["value"]
+	if !exists && operator == "" {
+		expected = true
+	}
+	count := 0
+	for _, value := range values {
+		holds, err := utils.EvaluatePredicate(operator, value, expected)
+		if err != nil {
+			return 0, err
+		}
+		if holds {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func Any(values []interface{}, attr map[string]interface{}) error {
+	count, err := countMatching(values, attr)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.New("none of the values match")
+	}
+	return nil
+}
+
+func All(values []interface{}, attr map[string]interface{}) error {
+	count, err := countMatching(values, attr)
+	if err != nil {
+		return err
+	}
+	if count != len(values) {
+		return fmt.Errorf("%d of the values do not match", len(values)-count)
+	}
+	return nil
+}
+
+func None(values []interface{}, attr map[string]interface{}) error {
+	count, err := countMatching(values, attr)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%d of the values match", count)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package archives
+
+import (
+	"strings"
+	"sync"
+)
+
+type Country struct {
+	Alpha2  string
+	Alpha3  string
+	Numeric string
+	Name    string
+}
+
+var countryIndex map[string]*Country
+var countryIndexOnce sync.Once
+
+func indexCountries() {
+	countryIndex = make(map[string]*Country, 4*len(countries))
+	for i := range countries {
+		c := &countries[i]
+		for _, key := range []string{c.Alpha2, c.Alpha3, c.Numeric, c.Name} {
+			countryIndex[strings.ToLower(key)] = c
+		}
+	}
+}
+
+// Countries returns the ISO 3166-1 countries sorted by their alpha-2 code
+func Countries() []Country {
+	return append([]Country(nil), countries...)
+}
+
+// FindCountry finds the country by its alpha-2, alpha-3 or numeric code or by its name, the case is ignored
+func FindCountry(code string) (Country, bool) {
+	countryIndexOnce.Do(indexCountries)
+	c, exists := countryIndex[strings.ToLower(strings.TrimSpace(code))]
+	if !exists {
+		return Country{}, false
+	}
+	return *c, true
+}
+
+// GetCountries returns the names of the countries by their alpha-2 code
+func GetCountries() *map[string]string {
+	countryMap := make(map[string]string, len(countries))
+	for _, c := range countries {
+		countryMap[c.Alpha2] = c.Name
+	}
+	return &countryMap
+}
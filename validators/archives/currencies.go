@@ -0,0 +1,40 @@
+package archives
+
+import (
+	"strings"
+	"sync"
+)
+
+type Currency struct {
+	Code       string
+	Numeric    string
+	Name       string
+	MinorUnits int
+}
+
+var currencyIndex map[string]*Currency
+var currencyIndexOnce sync.Once
+
+func indexCurrencies() {
+	currencyIndex = make(map[string]*Currency, 2*len(currencies))
+	for i := range currencies {
+		c := &currencies[i]
+		currencyIndex[strings.ToLower(c.Code)] = c
+		currencyIndex[c.Numeric] = c
+	}
+}
+
+// Currencies returns the ISO 4217 currencies sorted by their code
+func Currencies() []Currency {
+	return append([]Currency(nil), currencies...)
+}
+
+// FindCurrency finds the currency by its alphabetic or numeric code, the case is ignored
+func FindCurrency(code string) (Currency, bool) {
+	currencyIndexOnce.Do(indexCurrencies)
+	c, exists := currencyIndex[strings.ToLower(strings.TrimSpace(code))]
+	if !exists {
+		return Currency{}, false
+	}
+	return *c, true
+}
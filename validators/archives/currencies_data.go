@@ -0,0 +1,187 @@
+package archives
+
+// currencies are the ISO 4217 currencies, generated from the iso-codes 4.15.0,
+// the minor units are -1 for the funds and the metals which have none
+var currencies = []Currency{
+	{Code: "AED", Numeric: "784", Name: "UAE Dirham", MinorUnits: 2},
+	{Code: "AFN", Numeric: "971", Name: "Afghani", MinorUnits: 2},
+	{Code: "ALL", Numeric: "008", Name: "Lek", MinorUnits: 2},
+	{Code: "AMD", Numeric: "051", Name: "Armenian Dram", MinorUnits: 2},
+	{Code: "ANG", Numeric: "532", Name: "Netherlands Antillean Guilder", MinorUnits: 2},
+	{Code: "AOA", Numeric: "973", Name: "Kwanza", MinorUnits: 2},
+	{Code: "ARS", Numeric: "032", Name: "Argentine Peso", MinorUnits: 2},
+	{Code: "AUD", Numeric: "036", Name: "Australian Dollar", MinorUnits: 2},
+	{Code: "AWG", Numeric: "533", Name: "Aruban Florin", MinorUnits: 2},
+	{Code: "AZN", Numeric: "944", Name: "Azerbaijan Manat", MinorUnits: 2},
+	{Code: "BAM", Numeric: "977", Name: "Convertible Mark", MinorUnits: 2},
+	{Code: "BBD", Numeric: "052", Name: "Barbados Dollar", MinorUnits: 2},
+	{Code: "BDT", Numeric: "050", Name: "Taka", MinorUnits: 2},
+	{Code: "BGN", Numeric: "975", Name: "Bulgarian Lev", MinorUnits: 2},
+	{Code: "BHD", Numeric: "048", Name: "Bahraini Dinar", MinorUnits: 3},
+	{Code: "BIF", Numeric: "108", Name: "Burundi Franc", MinorUnits: 0},
+	{Code: "BMD", Numeric: "060", Name: "Bermudian Dollar", MinorUnits: 2},
+	{Code: "BND", Numeric: "096", Name: "Brunei Dollar", MinorUnits: 2},
+	{Code: "BOB", Numeric: "068", Name: "Boliviano", MinorUnits: 2},
+	{Code: "BOV", Numeric: "984", Name: "Mvdol", MinorUnits: 2},
+	{Code: "BRL", Numeric: "986", Name: "Brazilian Real", MinorUnits: 2},
+	{Code: "BSD", Numeric: "044", Name: "Bahamian Dollar", MinorUnits: 2},
+	{Code: "BTN", Numeric: "064", Name: "Ngultrum", MinorUnits: 2},
+	{Code: "BWP", Numeric: "072", Name: "Pula", MinorUnits: 2},
+	{Code: "BYN", Numeric: "933", Name: "Belarusian Ruble", MinorUnits: 2},
+	{Code: "BZD", Numeric: "084", Name: "Belize Dollar", MinorUnits: 2},
+	{Code: "CAD", Numeric: "124", Name: "Canadian Dollar", MinorUnits: 2},
+	{Code: "CDF", Numeric: "976", Name: "Congolese Franc", MinorUnits: 2},
+	{Code: "CHE", Numeric: "947", Name: "WIR Euro", MinorUnits: 2},
+	{Code: "CHF", Numeric: "756", Name: "Swiss Franc", MinorUnits: 2},
+	{Code: "CHW", Numeric: "948", Name: "WIR Franc", MinorUnits: 2},
+	{Code: "CLF", Numeric: "990", Name: "Unidad de Fomento", MinorUnits: 4},
+	{Code: "CLP", Numeric: "152", Name: "Chilean Peso", MinorUnits: 0},
+	{Code: "CNY", Numeric: "156", Name: "Yuan Renminbi", MinorUnits: 2},
+	{Code: "COP", Numeric: "170", Name: "Colombian Peso", MinorUnits: 2},
+	{Code: "COU", Numeric: "970", Name: "Unidad de Valor Real", MinorUnits: 2},
+	{Code: "CRC", Numeric: "188", Name: "Costa Rican Colon", MinorUnits: 2},
+	{Code: "CUC", Numeric: "931", Name: "Peso Convertible", MinorUnits: 2},
+	{Code: "CUP", Numeric: "192", Name: "Cuban Peso", MinorUnits: 2},
+	{Code: "CVE", Numeric: "132", Name: "Cabo Verde Escudo", MinorUnits: 2},
+	{Code: "CZK", Numeric: "203", Name: "Czech Koruna", MinorUnits: 2},
+	{Code: "DJF", Numeric: "262", Name: "Djibouti Franc", MinorUnits: 0},
+	{Code: "DKK", Numeric: "208", Name: "Danish Krone", MinorUnits: 2},
+	{Code: "DOP", Numeric: "214", Name: "Dominican Peso", MinorUnits: 2},
+	{Code: "DZD", Numeric: "012", Name: "Algerian Dinar", MinorUnits: 2},
+	{Code: "EGP", Numeric: "818", Name: "Egyptian Pound", MinorUnits: 2},
+	{Code: "ERN", Numeric: "232", Name: "Nakfa", MinorUnits: 2},
+	{Code: "ETB", Numeric: "230", Name: "Ethiopian Birr", MinorUnits: 2},
+	{Code: "EUR", Numeric: "978", Name: "Euro", MinorUnits: 2},
+	{Code: "FJD", Numeric: "242", Name: "Fiji Dollar", MinorUnits: 2},
+	{Code: "FKP", Numeric: "238", Name: "Falkland Islands Pound", MinorUnits: 2},
+	{Code: "GBP", Numeric: "826", Name: "Pound Sterling", MinorUnits: 2},
+	{Code: "GEL", Numeric: "981", Name: "Lari", MinorUnits: 2},
+	{Code: "GHS", Numeric: "936", Name: "Ghana Cedi", MinorUnits: 2},
+	{Code: "GIP", Numeric: "292", Name: "Gibraltar Pound", MinorUnits: 2},
+	{Code: "GMD", Numeric: "270", Name: "Dalasi", MinorUnits: 2},
+	{Code: "GNF", Numeric: "324", Name: "Guinean Franc", MinorUnits: 0},
+	{Code: "GTQ", Numeric: "320", Name: "Quetzal", MinorUnits: 2},
+	{Code: "GYD", Numeric: "328", Name: "Guyana Dollar", MinorUnits: 2},
+	{Code: "HKD", Numeric: "344", Name: "Hong Kong Dollar", MinorUnits: 2},
+	{Code: "HNL", Numeric: "340", Name: "Lempira", MinorUnits: 2},
+	{Code: "HRK", Numeric: "191", Name: "Kuna", MinorUnits: 2},
+	{Code: "HTG", Numeric: "332", Name: "Gourde", MinorUnits: 2},
+	{Code: "HUF", Numeric: "348", Name: "Forint", MinorUnits: 2},
+	{Code: "IDR", Numeric: "360", Name: "Rupiah", MinorUnits: 2},
+	{Code: "ILS", Numeric: "376", Name: "New Israeli Sheqel", MinorUnits: 2},
+	{Code: "INR", Numeric: "356", Name: "Indian Rupee", MinorUnits: 2},
+	{Code: "IQD", Numeric: "368", Name: "Iraqi Dinar", MinorUnits: 3},
+	{Code: "IRR", Numeric: "364", Name: "Iranian Rial", MinorUnits: 2},
+	{Code: "ISK", Numeric: "352", Name: "Iceland Krona", MinorUnits: 0},
+	{Code: "JMD", Numeric: "388", Name: "Jamaican Dollar", MinorUnits: 2},
+	{Code: "JOD", Numeric: "400", Name: "Jordanian Dinar", MinorUnits: 3},
+	{Code: "JPY", Numeric: "392", Name: "Yen", MinorUnits: 0},
+	{Code: "KES", Numeric: "404", Name: "Kenyan Shilling", MinorUnits: 2},
+	{Code: "KGS", Numeric: "417", Name: "Som", MinorUnits: 2},
+	{Code: "KHR", Numeric: "116", Name: "Riel", MinorUnits: 2},
+	{Code: "KMF", Numeric: "174", Name: "Comorian Franc", MinorUnits: 0},
+	{Code: "KPW", Numeric: "408", Name: "North Korean Won", MinorUnits: 2},
+	{Code: "KRW", Numeric: "410", Name: "Won", MinorUnits: 0},
+	{Code: "KWD", Numeric: "414", Name: "Kuwaiti Dinar", MinorUnits: 3},
+	{Code: "KYD", Numeric: "136", Name: "Cayman Islands Dollar", MinorUnits: 2},
+	{Code: "KZT", Numeric: "398", Name: "Tenge", MinorUnits: 2},
+	{Code: "LAK", Numeric: "418", Name: "Lao Kip", MinorUnits: 2},
+	{Code: "LBP", Numeric: "422", Name: "Lebanese Pound", MinorUnits: 2},
+	{Code: "LKR", Numeric: "144", Name: "Sri Lanka Rupee", MinorUnits: 2},
+	{Code: "LRD", Numeric: "430", Name: "Liberian Dollar", MinorUnits: 2},
+	{Code: "LSL", Numeric: "426", Name: "Loti", MinorUnits: 2},
+	{Code: "LYD", Numeric: "434", Name: "Libyan Dinar", MinorUnits: 3},
+	{Code: "MAD", Numeric: "504", Name: "Moroccan Dirham", MinorUnits: 2},
+	{Code: "MDL", Numeric: "498", Name: "Moldovan Leu", MinorUnits: 2},
+	{Code: "MGA", Numeric: "969", Name: "Malagasy Ariary", MinorUnits: 2},
+	{Code: "MKD", Numeric: "807", Name: "Denar", MinorUnits: 2},
+	{Code: "MMK", Numeric: "104", Name: "Kyat", MinorUnits: 2},
+	{Code: "MNT", Numeric: "496", Name: "Tugrik", MinorUnits: 2},
+	{Code: "MOP", Numeric: "446", Name: "Pataca", MinorUnits: 2},
+	{Code: "MRU", Numeric: "929", Name: "Ouguiya", MinorUnits: 2},
+	{Code: "MUR", Numeric: "480", Name: "Mauritius Rupee", MinorUnits: 2},
+	{Code: "MVR", Numeric: "462", Name: "Rufiyaa", MinorUnits: 2},
+	{Code: "MWK", Numeric: "454", Name: "Malawi Kwacha", MinorUnits: 2},
+	{Code: "MXN", Numeric: "484", Name: "Mexican Peso", MinorUnits: 2},
+	{Code: "MXV", Numeric: "979", Name: "Mexican Unidad de Inversion (UDI)", MinorUnits: 2},
+	{Code: "MYR", Numeric: "458", Name: "Malaysian Ringgit", MinorUnits: 2},
+	{Code: "MZN", Numeric: "943", Name: "Mozambique Metical", MinorUnits: 2},
+	{Code: "NAD", Numeric: "516", Name: "Namibia Dollar", MinorUnits: 2},
+	{Code: "NGN", Numeric: "566", Name: "Naira", MinorUnits: 2},
+	{Code: "NIO", Numeric: "558", Name: "Cordoba Oro", MinorUnits: 2},
+	{Code: "NOK", Numeric: "578", Name: "Norwegian Krone", MinorUnits: 2},
+	{Code: "NPR", Numeric: "524", Name: "Nepalese Rupee", MinorUnits: 2},
+	{Code: "NZD", Numeric: "554", Name: "New Zealand Dollar", MinorUnits: 2},
+	{Code: "OMR", Numeric: "512", Name: "Rial Omani", MinorUnits: 3},
+	{Code: "PAB", Numeric: "590", Name: "Balboa", MinorUnits: 2},
+	{Code: "PEN", Numeric: "604", Name: "Sol", MinorUnits: 2},
+	{Code: "PGK", Numeric: "598", Name: "Kina", MinorUnits: 2},
+	{Code: "PHP", Numeric: "608", Name: "Philippine Peso", MinorUnits: 2},
+	{Code: "PKR", Numeric: "586", Name: "Pakistan Rupee", MinorUnits: 2},
+	{Code: "PLN", Numeric: "985", Name: "Zloty", MinorUnits: 2},
+	{Code: "PYG", Numeric: "600", Name: "Guarani", MinorUnits: 0},
+	{Code: "QAR", Numeric: "634", Name: "Qatari Rial", MinorUnits: 2},
+	{Code: "RON", Numeric: "946", Name: "Romanian Leu", MinorUnits: 2},
+	{Code: "RSD", Numeric: "941", Name: "Serbian Dinar", MinorUnits: 2},
+	{Code: "RUB", Numeric: "643", Name: "Russian Ruble", MinorUnits: 2},
+	{Code: "RWF", Numeric: "646", Name: "Rwanda Franc", MinorUnits: 0},
+	{Code: "SAR", Numeric: "682", Name: "Saudi Riyal", MinorUnits: 2},
+	{Code: "SBD", Numeric: "090", Name: "Solomon Islands Dollar", MinorUnits: 2},
+	{Code: "SCR", Numeric: "690", Name: "Seychelles Rupee", MinorUnits: 2},
+	{Code: "SDG", Numeric: "938", Name: "Sudanese Pound", MinorUnits: 2},
+	{Code: "SEK", Numeric: "752", Name: "Swedish Krona", MinorUnits: 2},
+	{Code: "SGD", Numeric: "702", Name: "Singapore Dollar", MinorUnits: 2},
+	{Code: "SHP", Numeric: "654", Name: "Saint Helena Pound", MinorUnits: 2},
+	{Code: "SLE", Numeric: "925", Name: "Leone", MinorUnits: 2},
+	{Code: "SLL", Numeric: "694", Name: "Leone", MinorUnits: 2},
+	{Code: "SOS", Numeric: "706", Name: "Somali Shilling", MinorUnits: 2},
+	{Code: "SRD", Numeric: "968", Name: "Surinam Dollar", MinorUnits: 2},
+	{Code: "SSP", Numeric: "728", Name: "South Sudanese Pound", MinorUnits: 2},
+	{Code: "STN", Numeric: "930", Name: "Dobra", MinorUnits: 2},
+	{Code: "SVC", Numeric: "222", Name: "El Salvador Colon", MinorUnits: 2},
+	{Code: "SYP", Numeric: "760", Name: "Syrian Pound", MinorUnits: 2},
+	{Code: "SZL", Numeric: "748", Name: "Lilangeni", MinorUnits: 2},
+	{Code: "THB", Numeric: "764", Name: "Baht", MinorUnits: 2},
+	{Code: "TJS", Numeric: "972", Name: "Somoni", MinorUnits: 2},
+	{Code: "TMT", Numeric: "934", Name: "Turkmenistan New Manat", MinorUnits: 2},
+	{Code: "TND", Numeric: "788", Name: "Tunisian Dinar", MinorUnits: 3},
+	{Code: "TOP", Numeric: "776", Name: "Pa’anga", MinorUnits: 2},
+	{Code: "TRY", Numeric: "949", Name: "Turkish Lira", MinorUnits: 2},
+	{Code: "TTD", Numeric: "780", Name: "Trinidad and Tobago Dollar", MinorUnits: 2},
+	{Code: "TWD", Numeric: "901", Name: "New Taiwan Dollar", MinorUnits: 2},
+	{Code: "TZS", Numeric: "834", Name: "Tanzanian Shilling", MinorUnits: 2},
+	{Code: "UAH", Numeric: "980", Name: "Hryvnia", MinorUnits: 2},
+	{Code: "UGX", Numeric: "800", Name: "Uganda Shilling", MinorUnits: 0},
+	{Code: "USD", Numeric: "840", Name: "US Dollar", MinorUnits: 2},
+	{Code: "USN", Numeric: "997", Name: "US Dollar (Next day)", MinorUnits: 2},
+	{Code: "UYI", Numeric: "940", Name: "Uruguay Peso en Unidades Indexadas (UI)", MinorUnits: 0},
+	{Code: "UYU", Numeric: "858", Name: "Peso Uruguayo", MinorUnits: 2},
+	{Code: "UYW", Numeric: "927", Name: "Unidad Previsional", MinorUnits: 4},
+	{Code: "UZS", Numeric: "860", Name: "Uzbekistan Sum", MinorUnits: 2},
+	{Code: "VED", Numeric: "926", Name: "Bolívar Soberano", MinorUnits: 2},
+	{Code: "VES", Numeric: "928", Name: "Bolívar Soberano", MinorUnits: 2},
+	{Code: "VND", Numeric: "704", Name: "Dong", MinorUnits: 0},
+	{Code: "VUV", Numeric: "548", Name: "Vatu", MinorUnits: 0},
+	{Code: "WST", Numeric: "882", Name: "Tala", MinorUnits: 2},
+	{Code: "XAF", Numeric: "950", Name: "CFA Franc BEAC", MinorUnits: 0},
+	{Code: "XAG", Numeric: "961", Name: "Silver", MinorUnits: -1},
+	{Code: "XAU", Numeric: "959", Name: "Gold", MinorUnits: -1},
+	{Code: "XBA", Numeric: "955", Name: "Bond Markets Unit European Composite Unit (EURCO)", MinorUnits: -1},
+	{Code: "XBB", Numeric: "956", Name: "Bond Markets Unit European Monetary Unit (E.M.U.-6)", MinorUnits: -1},
+	{Code: "XBC", Numeric: "957", Name: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)", MinorUnits: -1},
+	{Code: "XBD", Numeric: "958", Name: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)", MinorUnits: -1},
+	{Code: "XCD", Numeric: "951", Name: "East Caribbean Dollar", MinorUnits: 2},
+	{Code: "XDR", Numeric: "960", Name: "SDR (Special Drawing Right)", MinorUnits: -1},
+	{Code: "XOF", Numeric: "952", Name: "CFA Franc BCEAO", MinorUnits: 0},
+	{Code: "XPD", Numeric: "964", Name: "Palladium", MinorUnits: -1},
+	{Code: "XPF", Numeric: "953", Name: "CFP Franc", MinorUnits: 0},
+	{Code: "XPT", Numeric: "962", Name: "Platinum", MinorUnits: -1},
+	{Code: "XSU", Numeric: "994", Name: "Sucre", MinorUnits: -1},
+	{Code: "XTS", Numeric: "963", Name: "Codes specifically reserved for testing purposes", MinorUnits: -1},
+	{Code: "XUA", Numeric: "965", Name: "ADB Unit of Account", MinorUnits: -1},
+	{Code: "XXX", Numeric: "999", Name: "The codes assigned for transactions where no currency is involved", MinorUnits: -1},
+	{Code: "YER", Numeric: "886", Name: "Yemeni Rial", MinorUnits: 2},
+	{Code: "ZAR", Numeric: "710", Name: "Rand", MinorUnits: 2},
+	{Code: "ZMW", Numeric: "967", Name: "Zambian Kwacha", MinorUnits: 2},
+	{Code: "ZWL", Numeric: "932", Name: "Zimbabwe Dollar", MinorUnits: 2},
+}
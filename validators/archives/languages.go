@@ -0,0 +1,168 @@
+package archives
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type Language struct {
+	Alpha2 string
+	Alpha3 string
+	Name   string
+}
+
+var languageIndex map[string]*Language
+var languageCodeIndex map[string]bool
+var scriptIndex map[string]bool
+var languageIndexOnce sync.Once
+
+func indexLanguages() {
+	languageIndex = make(map[string]*Language, 3*len(languages))
+	for i := range languages {
+		l := &languages[i]
+		for _, key := range []string{l.Alpha2, l.Alpha3, l.Name} {
+			if key != "" {
+				languageIndex[strings.ToLower(key)] = l
+			}
+		}
+	}
+	languageCodeIndex = make(map[string]bool)
+	for _, code := range strings.Fields(languageCodes) {
+		languageCodeIndex[code] = true
+	}
+	scriptIndex = make(map[string]bool)
+	for _, code := range strings.Fields(scriptCodes) {
+		scriptIndex[strings.ToLower(code)] = true
+	}
+}
+
+// Languages returns the ISO 639-2 languages
+func Languages() []Language {
+	return append([]Language(nil), languages...)
+}
+
+// FindLanguage finds the language by its ISO 639-1 or ISO 639-2 code or by its name, the case is ignored
+func FindLanguage(code string) (Language, bool) {
+	languageIndexOnce.Do(indexLanguages)
+	l, exists := languageIndex[strings.ToLower(strings.TrimSpace(code))]
+	if !exists {
+		return Language{}, false
+	}
+	return *l, true
+}
+
+func isLanguageCode(code string) bool {
+	languageIndexOnce.Do(indexLanguages)
+	if _, exists := languageIndex[code]; exists && len(code) <= 3 {
+		return true
+	}
+	return languageCodeIndex[code]
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphaNumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateLanguageTag checks the BCP 47 language tag like "en", "ar-SA", "zh-Hant-TW" or "sr-Latn-RS-x-private",
+// the language, the script and the region have to be known codes, the case is ignored
+func ValidateLanguageTag(tag string) error {
+	subtags := strings.Split(strings.ToLower(strings.TrimSpace(tag)), "-")
+	if subtags[0] == "x" {
+		return validatePrivateUse(tag, subtags[1:])
+	}
+	language := subtags[0]
+	if !isAlpha(language) || len(language) < 2 || len(language) > 3 || !isLanguageCode(language) {
+		return fmt.Errorf("%s is not a known language in %s", language, tag)
+	}
+	i := 1
+	// extended language subtags
+	for extlangs := 0; i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]) && extlangs < 3; extlangs++ {
+		if !isLanguageCode(subtags[i]) {
+			return fmt.Errorf("%s is not a known language in %s", subtags[i], tag)
+		}
+		i++
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		if !scriptIndex[subtags[i]] {
+			return fmt.Errorf("%s is not a known script in %s", subtags[i], tag)
+		}
+		i++
+	}
+	if i < len(subtags) && ((len(subtags[i]) == 2 && isAlpha(subtags[i])) || (len(subtags[i]) == 3 && isDigits(subtags[i]))) {
+		if isAlpha(subtags[i]) {
+			if _, exists := FindCountry(subtags[i]); !exists {
+				return fmt.Errorf("%s is not a known region in %s", subtags[i], tag)
+			}
+		}
+		i++
+	}
+	seen := make(map[string]bool)
+	for ; i < len(subtags); i++ {
+		subtag := subtags[i]
+		variant := (len(subtag) >= 5 && len(subtag) <= 8) || (len(subtag) == 4 && isDigits(subtag[:1]))
+		if !variant || !isAlphaNumeric(subtag) {
+			break
+		}
+		if seen[subtag] {
+			return fmt.Errorf("%s is repeated in %s", subtag, tag)
+		}
+		seen[subtag] = true
+	}
+	for i < len(subtags) {
+		singleton := subtags[i]
+		if len(singleton) != 1 || !isAlphaNumeric(singleton) {
+			return fmt.Errorf("%s is not a valid subtag in %s", singleton, tag)
+		}
+		if singleton == "x" {
+			return validatePrivateUse(tag, subtags[i+1:])
+		}
+		if seen[singleton] {
+			return fmt.Errorf("extension %s is repeated in %s", singleton, tag)
+		}
+		seen[singleton] = true
+		i++
+		start := i
+		for ; i < len(subtags) && len(subtags[i]) >= 2 && len(subtags[i]) <= 8 && isAlphaNumeric(subtags[i]); i++ {
+		}
+		if i == start {
+			return fmt.Errorf("extension %s is empty in %s", singleton, tag)
+		}
+	}
+	return nil
+}
+
+func validatePrivateUse(tag string, subtags []string) error {
+	if len(subtags) == 0 {
+		return fmt.Errorf("private use is empty in %s", tag)
+	}
+	for _, subtag := range subtags {
+		if len(subtag) < 1 || len(subtag) > 8 || !isAlphaNumeric(subtag) {
+			return fmt.Errorf("%s is not a valid private use subtag in %s", subtag, tag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,772 @@
+package archives
+
+// languages are the ISO 639-2 languages with their ISO 639-1 codes, generated from the iso-codes 4.15.0
+var languages = []Language{
+	{Alpha2: "aa", Alpha3: "aar", Name: "Afar"},
+	{Alpha2: "ab", Alpha3: "abk", Name: "Abkhazian"},
+	{Alpha2: "", Alpha3: "ace", Name: "Achinese"},
+	{Alpha2: "", Alpha3: "ach", Name: "Acoli"},
+	{Alpha2: "", Alpha3: "ada", Name: "Adangme"},
+	{Alpha2: "", Alpha3: "ady", Name: "Adyghe; Adygei"},
+	{Alpha2: "", Alpha3: "afa", Name: "Afro-Asiatic languages"},
+	{Alpha2: "", Alpha3: "afh", Name: "Afrihili"},
+	{Alpha2: "af", Alpha3: "afr", Name: "Afrikaans"},
+	{Alpha2: "", Alpha3: "ain", Name: "Ainu"},
+	{Alpha2: "ak", Alpha3: "aka", Name: "Akan"},
+	{Alpha2: "", Alpha3: "akk", Name: "Akkadian"},
+	{Alpha2: "", Alpha3: "ale", Name: "Aleut"},
+	{Alpha2: "", Alpha3: "alg", Name: "Algonquian languages"},
+	{Alpha2: "", Alpha3: "alt", Name: "Southern Altai"},
+	{Alpha2: "am", Alpha3: "amh", Name: "Amharic"},
+	{Alpha2: "", Alpha3: "ang", Name: "English, Old (ca. 450-1100)"},
+	{Alpha2: "", Alpha3: "anp", Name: "Angika"},
+	{Alpha2: "", Alpha3: "apa", Name: "Apache languages"},
+	{Alpha2: "ar", Alpha3: "ara", Name: "Arabic"},
+	{Alpha2: "", Alpha3: "arc", Name: "Official Aramaic (700-300 BCE); Imperial Aramaic (700-300 BCE)"},
+	{Alpha2: "an", Alpha3: "arg", Name: "Aragonese"},
+	{Alpha2: "", Alpha3: "arn", Name: "Mapudungun; Mapuche"},
+	{Alpha2: "", Alpha3: "arp", Name: "Arapaho"},
+	{Alpha2: "", Alpha3: "art", Name: "Artificial languages"},
+	{Alpha2: "", Alpha3: "arw", Name: "Arawak"},
+	{Alpha2: "as", Alpha3: "asm", Name: "Assamese"},
+	{Alpha2: "", Alpha3: "ast", Name: "Asturian; Bable; Leonese; Asturleonese"},
+	{Alpha2: "", Alpha3: "ath", Name: "Athapascan languages"},
+	{Alpha2: "", Alpha3: "aus", Name: "Australian languages"},
+	{Alpha2: "av", Alpha3: "ava", Name: "Avaric"},
+	{Alpha2: "ae", Alpha3: "ave", Name: "Avestan"},
+	{Alpha2: "", Alpha3: "awa", Name: "Awadhi"},
+	{Alpha2: "ay", Alpha3: "aym", Name: "Aymara"},
+	{Alpha2: "az", Alpha3: "aze", Name: "Azerbaijani"},
+	{Alpha2: "", Alpha3: "bad", Name: "Banda languages"},
+	{Alpha2: "", Alpha3: "bai", Name: "Bamileke languages"},
+	{Alpha2: "ba", Alpha3: "bak", Name: "Bashkir"},
+	{Alpha2: "", Alpha3: "bal", Name: "Baluchi"},
+	{Alpha2: "bm", Alpha3: "bam", Name: "Bambara"},
+	{Alpha2: "", Alpha3: "ban", Name: "Balinese"},
+	{Alpha2: "", Alpha3: "bas", Name: "Basa"},
+	{Alpha2: "", Alpha3: "bat", Name: "Baltic languages"},
+	{Alpha2: "", Alpha3: "bej", Name: "Beja; Bedawiyet"},
+	{Alpha2: "be", Alpha3: "bel", Name: "Belarusian"},
+	{Alpha2: "", Alpha3: "bem", Name: "Bemba"},
+	{Alpha2: "bn", Alpha3: "ben", Name: "Bengali"},
+	{Alpha2: "", Alpha3: "ber", Name: "Berber languages"},
+	{Alpha2: "", Alpha3: "bho", Name: "Bhojpuri"},
+	{Alpha2: "bh", Alpha3: "bih", Name: "Bihari languages"},
+	{Alpha2: "", Alpha3: "bik", Name: "Bikol"},
+	{Alpha2: "", Alpha3: "bin", Name: "Bini; Edo"},
+	{Alpha2: "bi", Alpha3: "bis", Name: "Bislama"},
+	{Alpha2: "", Alpha3: "bla", Name: "Siksika"},
+	{Alpha2: "", Alpha3: "bnt", Name: "Bantu (Other)"},
+	{Alpha2: "bo", Alpha3: "bod", Name: "Tibetan"},
+	{Alpha2: "bs", Alpha3: "bos", Name: "Bosnian"},
+	{Alpha2: "", Alpha3: "bra", Name: "Braj"},
+	{Alpha2: "br", Alpha3: "bre", Name: "Breton"},
+	{Alpha2: "", Alpha3: "btk", Name: "Batak languages"},
+	{Alpha2: "", Alpha3: "bua", Name: "Buriat"},
+	{Alpha2: "", Alpha3: "bug", Name: "Buginese"},
+	{Alpha2: "bg", Alpha3: "bul", Name: "Bulgarian"},
+	{Alpha2: "", Alpha3: "byn", Name: "Blin; Bilin"},
+	{Alpha2: "", Alpha3: "cad", Name: "Caddo"},
+	{Alpha2: "", Alpha3: "cai", Name: "Central American Indian languages"},
+	{Alpha2: "", Alpha3: "car", Name: "Galibi Carib"},
+	{Alpha2: "ca", Alpha3: "cat", Name: "Catalan; Valencian"},
+	{Alpha2: "", Alpha3: "cau", Name: "Caucasian languages"},
+	{Alpha2: "", Alpha3: "ceb", Name: "Cebuano"},
+	{Alpha2: "", Alpha3: "cel", Name: "Celtic languages"},
+	{Alpha2: "cs", Alpha3: "ces", Name: "Czech"},
+	{Alpha2: "ch", Alpha3: "cha", Name: "Chamorro"},
+	{Alpha2: "", Alpha3: "chb", Name: "Chibcha"},
+	{Alpha2: "ce", Alpha3: "che", Name: "Chechen"},
+	{Alpha2: "", Alpha3: "chg", Name: "Chagatai"},
+	{Alpha2: "", Alpha3: "chk", Name: "Chuukese"},
+	{Alpha2: "", Alpha3: "chm", Name: "Mari"},
+	{Alpha2: "", Alpha3: "chn", Name: "Chinook jargon"},
+	{Alpha2: "", Alpha3: "cho", Name: "Choctaw"},
+	{Alpha2: "", Alpha3: "chp", Name: "Chipewyan; Dene Suline"},
+	{Alpha2: "", Alpha3: "chr", Name: "Cherokee"},
+	{Alpha2: "cu", Alpha3: "chu", Name: "Church Slavic; Old Slavonic; Church Slavonic; Old Bulgarian; Old Church Slavonic"},
+	{Alpha2: "cv", Alpha3: "chv", Name: "Chuvash"},
+	{Alpha2: "", Alpha3: "chy", Name: "Cheyenne"},
+	{Alpha2: "", Alpha3: "cmc", Name: "Chamic languages"},
+	{Alpha2: "", Alpha3: "cnr", Name: "Montenegrin"},
+	{Alpha2: "", Alpha3: "cop", Name: "Coptic"},
+	{Alpha2: "kw", Alpha3: "cor", Name: "Cornish"},
+	{Alpha2: "co", Alpha3: "cos", Name: "Corsican"},
+	{Alpha2: "", Alpha3: "cpe", Name: "Creoles and pidgins, English based"},
+	{Alpha2: "", Alpha3: "cpf", Name: "Creoles and pidgins, French-based"},
+	{Alpha2: "", Alpha3: "cpp", Name: "Creoles and pidgins, Portuguese-based"},
+	{Alpha2: "cr", Alpha3: "cre", Name: "Cree"},
+	{Alpha2: "", Alpha3: "crh", Name: "Crimean Tatar; Crimean Turkish"},
+	{Alpha2: "", Alpha3: "crp", Name: "Creoles and pidgins"},
+	{Alpha2: "", Alpha3: "csb", Name: "Kashubian"},
+	{Alpha2: "", Alpha3: "cus", Name: "Cushitic languages"},
+	{Alpha2: "cy", Alpha3: "cym", Name: "Welsh"},
+	{Alpha2: "", Alpha3: "dak", Name: "Dakota"},
+	{Alpha2: "da", Alpha3: "dan", Name: "Danish"},
+	{Alpha2: "", Alpha3: "dar", Name: "Dargwa"},
+	{Alpha2: "", Alpha3: "day", Name: "Land Dayak languages"},
+	{Alpha2: "", Alpha3: "del", Name: "Delaware"},
+	{Alpha2: "", Alpha3: "den", Name: "Slave (Athapascan)"},
+	{Alpha2: "de", Alpha3: "deu", Name: "German"},
+	{Alpha2: "", Alpha3: "dgr", Name: "Dogrib"},
+	{Alpha2: "", Alpha3: "din", Name: "Dinka"},
+	{Alpha2: "dv", Alpha3: "div", Name: "Divehi; Dhivehi; Maldivian"},
+	{Alpha2: "", Alpha3: "doi", Name: "Dogri"},
+	{Alpha2: "", Alpha3: "dra", Name: "Dravidian languages"},
+	{Alpha2: "", Alpha3: "dsb", Name: "Lower Sorbian"},
+	{Alpha2: "", Alpha3: "dua", Name: "Duala"},
+	{Alpha2: "", Alpha3: "dum", Name: "Dutch, Middle (ca. 1050-1350)"},
+	{Alpha2: "", Alpha3: "dyu", Name: "Dyula"},
+	{Alpha2: "dz", Alpha3: "dzo", Name: "Dzongkha"},
+	{Alpha2: "", Alpha3: "efi", Name: "Efik"},
+	{Alpha2: "", Alpha3: "egy", Name: "Egyptian (Ancient)"},
+	{Alpha2: "", Alpha3: "eka", Name: "Ekajuk"},
+	{Alpha2: "el", Alpha3: "ell", Name: "Greek, Modern (1453-)"},
+	{Alpha2: "", Alpha3: "elx", Name: "Elamite"},
+	{Alpha2: "en", Alpha3: "eng", Name: "English"},
+	{Alpha2: "", Alpha3: "enm", Name: "English, Middle (1100-1500)"},
+	{Alpha2: "eo", Alpha3: "epo", Name: "Esperanto"},
+	{Alpha2: "et", Alpha3: "est", Name: "Estonian"},
+	{Alpha2: "eu", Alpha3: "eus", Name: "Basque"},
+	{Alpha2: "ee", Alpha3: "ewe", Name: "Ewe"},
+	{Alpha2: "", Alpha3: "ewo", Name: "Ewondo"},
+	{Alpha2: "", Alpha3: "fan", Name: "Fang"},
+	{Alpha2: "fo", Alpha3: "fao", Name: "Faroese"},
+	{Alpha2: "fa", Alpha3: "fas", Name: "Persian"},
+	{Alpha2: "", Alpha3: "fat", Name: "Fanti"},
+	{Alpha2: "fj", Alpha3: "fij", Name: "Fijian"},
+	{Alpha2: "", Alpha3: "fil", Name: "Filipino; Pilipino"},
+	{Alpha2: "fi", Alpha3: "fin", Name: "Finnish"},
+	{Alpha2: "", Alpha3: "fiu", Name: "Finno-Ugrian languages"},
+	{Alpha2: "", Alpha3: "fon", Name: "Fon"},
+	{Alpha2: "fr", Alpha3: "fra", Name: "French"},
+	{Alpha2: "", Alpha3: "frm", Name: "French, Middle (ca. 1400-1600)"},
+	{Alpha2: "", Alpha3: "fro", Name: "French, Old (842-ca. 1400)"},
+	{Alpha2: "", Alpha3: "frr", Name: "Northern Frisian"},
+	{Alpha2: "", Alpha3: "frs", Name: "Eastern Frisian"},
+	{Alpha2: "fy", Alpha3: "fry", Name: "Western Frisian"},
+	{Alpha2: "ff", Alpha3: "ful", Name: "Fulah"},
+	{Alpha2: "", Alpha3: "fur", Name: "Friulian"},
+	{Alpha2: "", Alpha3: "gaa", Name: "Ga"},
+	{Alpha2: "", Alpha3: "gay", Name: "Gayo"},
+	{Alpha2: "", Alpha3: "gba", Name: "Gbaya"},
+	{Alpha2: "", Alpha3: "gem", Name: "Germanic languages"},
+	{Alpha2: "", Alpha3: "gez", Name: "Geez"},
+	{Alpha2: "", Alpha3: "gil", Name: "Gilbertese"},
+	{Alpha2: "gd", Alpha3: "gla", Name: "Gaelic; Scottish Gaelic"},
+	{Alpha2: "ga", Alpha3: "gle", Name: "Irish"},
+	{Alpha2: "gl", Alpha3: "glg", Name: "Galician"},
+	{Alpha2: "gv", Alpha3: "glv", Name: "Manx"},
+	{Alpha2: "", Alpha3: "gmh", Name: "German, Middle High (ca. 1050-1500)"},
+	{Alpha2: "", Alpha3: "goh", Name: "German, Old High (ca. 750-1050)"},
+	{Alpha2: "", Alpha3: "gon", Name: "Gondi"},
+	{Alpha2: "", Alpha3: "gor", Name: "Gorontalo"},
+	{Alpha2: "", Alpha3: "got", Name: "Gothic"},
+	{Alpha2: "", Alpha3: "grb", Name: "Grebo"},
+	{Alpha2: "", Alpha3: "grc", Name: "Greek, Ancient (to 1453)"},
+	{Alpha2: "gn", Alpha3: "grn", Name: "Guarani"},
+	{Alpha2: "", Alpha3: "gsw", Name: "Swiss German; Alemannic; Alsatian"},
+	{Alpha2: "gu", Alpha3: "guj", Name: "Gujarati"},
+	{Alpha2: "", Alpha3: "gwi", Name: "Gwich'in"},
+	{Alpha2: "", Alpha3: "hai", Name: "Haida"},
+	{Alpha2: "ht", Alpha3: "hat", Name: "Haitian; Haitian Creole"},
+	{Alpha2: "ha", Alpha3: "hau", Name: "Hausa"},
+	{Alpha2: "", Alpha3: "haw", Name: "Hawaiian"},
+	{Alpha2: "he", Alpha3: "heb", Name: "Hebrew"},
+	{Alpha2: "hz", Alpha3: "her", Name: "Herero"},
+	{Alpha2: "", Alpha3: "hil", Name: "Hiligaynon"},
+	{Alpha2: "", Alpha3: "him", Name: "Himachali languages; Western Pahari languages"},
+	{Alpha2: "hi", Alpha3: "hin", Name: "Hindi"},
+	{Alpha2: "", Alpha3: "hit", Name: "Hittite"},
+	{Alpha2: "", Alpha3: "hmn", Name: "Hmong; Mong"},
+	{Alpha2: "ho", Alpha3: "hmo", Name: "Hiri Motu"},
+	{Alpha2: "hr", Alpha3: "hrv", Name: "Croatian"},
+	{Alpha2: "", Alpha3: "hsb", Name: "Upper Sorbian"},
+	{Alpha2: "hu", Alpha3: "hun", Name: "Hungarian"},
+	{Alpha2: "", Alpha3: "hup", Name: "Hupa"},
+	{Alpha2: "hy", Alpha3: "hye", Name: "Armenian"},
+	{Alpha2: "", Alpha3: "iba", Name: "Iban"},
+	{Alpha2: "ig", Alpha3: "ibo", Name: "Igbo"},
+	{Alpha2: "io", Alpha3: "ido", Name: "Ido"},
+	{Alpha2: "ii", Alpha3: "iii", Name: "Sichuan Yi; Nuosu"},
+	{Alpha2: "", Alpha3: "ijo", Name: "Ijo languages"},
+	{Alpha2: "iu", Alpha3: "iku", Name: "Inuktitut"},
+	{Alpha2: "ie", Alpha3: "ile", Name: "Interlingue; Occidental"},
+	{Alpha2: "", Alpha3: "ilo", Name: "Iloko"},
+	{Alpha2: "ia", Alpha3: "ina", Name: "Interlingua (International Auxiliary Language Association)"},
+	{Alpha2: "", Alpha3: "inc", Name: "Indic languages"},
+	{Alpha2: "id", Alpha3: "ind", Name: "Indonesian"},
+	{Alpha2: "", Alpha3: "ine", Name: "Indo-European languages"},
+	{Alpha2: "", Alpha3: "inh", Name: "Ingush"},
+	{Alpha2: "ik", Alpha3: "ipk", Name: "Inupiaq"},
+	{Alpha2: "", Alpha3: "ira", Name: "Iranian languages"},
+	{Alpha2: "", Alpha3: "iro", Name: "Iroquoian languages"},
+	{Alpha2: "is", Alpha3: "isl", Name: "Icelandic"},
+	{Alpha2: "it", Alpha3: "ita", Name: "Italian"},
+	{Alpha2: "jv", Alpha3: "jav", Name: "Javanese"},
+	{Alpha2: "", Alpha3: "jbo", Name: "Lojban"},
+	{Alpha2: "ja", Alpha3: "jpn", Name: "Japanese"},
+	{Alpha2: "", Alpha3: "jpr", Name: "Judeo-Persian"},
+	{Alpha2: "", Alpha3: "jrb", Name: "Judeo-Arabic"},
+	{Alpha2: "", Alpha3: "kaa", Name: "Kara-Kalpak"},
+	{Alpha2: "", Alpha3: "kab", Name: "Kabyle"},
+	{Alpha2: "", Alpha3: "kac", Name: "Kachin; Jingpho"},
+	{Alpha2: "kl", Alpha3: "kal", Name: "Kalaallisut; Greenlandic"},
+	{Alpha2: "", Alpha3: "kam", Name: "Kamba"},
+	{Alpha2: "kn", Alpha3: "kan", Name: "Kannada"},
+	{Alpha2: "", Alpha3: "kar", Name: "Karen languages"},
+	{Alpha2: "ks", Alpha3: "kas", Name: "Kashmiri"},
+	{Alpha2: "ka", Alpha3: "kat", Name: "Georgian"},
+	{Alpha2: "kr", Alpha3: "kau", Name: "Kanuri"},
+	{Alpha2: "", Alpha3: "kaw", Name: "Kawi"},
+	{Alpha2: "kk", Alpha3: "kaz", Name: "Kazakh"},
+	{Alpha2: "", Alpha3: "kbd", Name: "Kabardian"},
+	{Alpha2: "", Alpha3: "kha", Name: "Khasi"},
+	{Alpha2: "", Alpha3: "khi", Name: "Khoisan languages"},
+	{Alpha2: "km", Alpha3: "khm", Name: "Central Khmer"},
+	{Alpha2: "", Alpha3: "kho", Name: "Khotanese; Sakan"},
+	{Alpha2: "ki", Alpha3: "kik", Name: "Kikuyu; Gikuyu"},
+	{Alpha2: "rw", Alpha3: "kin", Name: "Kinyarwanda"},
+	{Alpha2: "ky", Alpha3: "kir", Name: "Kirghiz; Kyrgyz"},
+	{Alpha2: "", Alpha3: "kmb", Name: "Kimbundu"},
+	{Alpha2: "", Alpha3: "kok", Name: "Konkani"},
+	{Alpha2: "kv", Alpha3: "kom", Name: "Komi"},
+	{Alpha2: "kg", Alpha3: "kon", Name: "Kongo"},
+	{Alpha2: "ko", Alpha3: "kor", Name: "Korean"},
+	{Alpha2: "", Alpha3: "kos", Name: "Kosraean"},
+	{Alpha2: "", Alpha3: "kpe", Name: "Kpelle"},
+	{Alpha2: "", Alpha3: "krc", Name: "Karachay-Balkar"},
+	{Alpha2: "", Alpha3: "krl", Name: "Karelian"},
+	{Alpha2: "", Alpha3: "kro", Name: "Kru languages"},
+	{Alpha2: "", Alpha3: "kru", Name: "Kurukh"},
+	{Alpha2: "kj", Alpha3: "kua", Name: "Kuanyama; Kwanyama"},
+	{Alpha2: "", Alpha3: "kum", Name: "Kumyk"},
+	{Alpha2: "ku", Alpha3: "kur", Name: "Kurdish"},
+	{Alpha2: "", Alpha3: "kut", Name: "Kutenai"},
+	{Alpha2: "", Alpha3: "lad", Name: "Ladino"},
+	{Alpha2: "", Alpha3: "lah", Name: "Lahnda"},
+	{Alpha2: "", Alpha3: "lam", Name: "Lamba"},
+	{Alpha2: "lo", Alpha3: "lao", Name: "Lao"},
+	{Alpha2: "la", Alpha3: "lat", Name: "Latin"},
+	{Alpha2: "lv", Alpha3: "lav", Name: "Latvian"},
+	{Alpha2: "", Alpha3: "lez", Name: "Lezghian"},
+	{Alpha2: "li", Alpha3: "lim", Name: "Limburgan; Limburger; Limburgish"},
+	{Alpha2: "ln", Alpha3: "lin", Name: "Lingala"},
+	{Alpha2: "lt", Alpha3: "lit", Name: "Lithuanian"},
+	{Alpha2: "", Alpha3: "lol", Name: "Mongo"},
+	{Alpha2: "", Alpha3: "loz", Name: "Lozi"},
+	{Alpha2: "lb", Alpha3: "ltz", Name: "Luxembourgish; Letzeburgesch"},
+	{Alpha2: "", Alpha3: "lua", Name: "Luba-Lulua"},
+	{Alpha2: "lu", Alpha3: "lub", Name: "Luba-Katanga"},
+	{Alpha2: "lg", Alpha3: "lug", Name: "Ganda"},
+	{Alpha2: "", Alpha3: "lui", Name: "Luiseno"},
+	{Alpha2: "", Alpha3: "lun", Name: "Lunda"},
+	{Alpha2: "", Alpha3: "luo", Name: "Luo (Kenya and Tanzania)"},
+	{Alpha2: "", Alpha3: "lus", Name: "Lushai"},
+	{Alpha2: "", Alpha3: "mad", Name: "Madurese"},
+	{Alpha2: "", Alpha3: "mag", Name: "Magahi"},
+	{Alpha2: "mh", Alpha3: "mah", Name: "Marshallese"},
+	{Alpha2: "", Alpha3: "mai", Name: "Maithili"},
+	{Alpha2: "", Alpha3: "mak", Name: "Makasar"},
+	{Alpha2: "ml", Alpha3: "mal", Name: "Malayalam"},
+	{Alpha2: "", Alpha3: "man", Name: "Mandingo"},
+	{Alpha2: "", Alpha3: "map", Name: "Austronesian languages"},
+	{Alpha2: "mr", Alpha3: "mar", Name: "Marathi"},
+	{Alpha2: "", Alpha3: "mas", Name: "Masai"},
+	{Alpha2: "", Alpha3: "mdf", Name: "Moksha"},
+	{Alpha2: "", Alpha3: "mdr", Name: "Mandar"},
+	{Alpha2: "", Alpha3: "men", Name: "Mende"},
+	{Alpha2: "", Alpha3: "mga", Name: "Irish, Middle (900-1200)"},
+	{Alpha2: "", Alpha3: "mic", Name: "Mi'kmaq; Micmac"},
+	{Alpha2: "", Alpha3: "min", Name: "Minangkabau"},
+	{Alpha2: "", Alpha3: "mis", Name: "Uncoded languages"},
+	{Alpha2: "mk", Alpha3: "mkd", Name: "Macedonian"},
+	{Alpha2: "", Alpha3: "mkh", Name: "Mon-Khmer languages"},
+	{Alpha2: "mg", Alpha3: "mlg", Name: "Malagasy"},
+	{Alpha2: "mt", Alpha3: "mlt", Name: "Maltese"},
+	{Alpha2: "", Alpha3: "mnc", Name: "Manchu"},
+	{Alpha2: "", Alpha3: "mni", Name: "Manipuri"},
+	{Alpha2: "", Alpha3: "mno", Name: "Manobo languages"},
+	{Alpha2: "", Alpha3: "moh", Name: "Mohawk"},
+	{Alpha2: "mn", Alpha3: "mon", Name: "Mongolian"},
+	{Alpha2: "", Alpha3: "mos", Name: "Mossi"},
+	{Alpha2: "mi", Alpha3: "mri", Name: "Maori"},
+	{Alpha2: "ms", Alpha3: "msa", Name: "Malay"},
+	{Alpha2: "", Alpha3: "mul", Name: "Multiple languages"},
+	{Alpha2: "", Alpha3: "mun", Name: "Munda languages"},
+	{Alpha2: "", Alpha3: "mus", Name: "Creek"},
+	{Alpha2: "", Alpha3: "mwl", Name: "Mirandese"},
+	{Alpha2: "", Alpha3: "mwr", Name: "Marwari"},
+	{Alpha2: "my", Alpha3: "mya", Name: "Burmese"},
+	{Alpha2: "", Alpha3: "myn", Name: "Mayan languages"},
+	{Alpha2: "", Alpha3: "myv", Name: "Erzya"},
+	{Alpha2: "", Alpha3: "nah", Name: "Nahuatl languages"},
+	{Alpha2: "", Alpha3: "nai", Name: "North American Indian languages"},
+	{Alpha2: "", Alpha3: "nap", Name: "Neapolitan"},
+	{Alpha2: "na", Alpha3: "nau", Name: "Nauru"},
+	{Alpha2: "nv", Alpha3: "nav", Name: "Navajo; Navaho"},
+	{Alpha2: "nr", Alpha3: "nbl", Name: "Ndebele, South; South Ndebele"},
+	{Alpha2: "nd", Alpha3: "nde", Name: "Ndebele, North; North Ndebele"},
+	{Alpha2: "ng", Alpha3: "ndo", Name: "Ndonga"},
+	{Alpha2: "", Alpha3: "nds", Name: "Low German; Low Saxon; German, Low; Saxon, Low"},
+	{Alpha2: "ne", Alpha3: "nep", Name: "Nepali"},
+	{Alpha2: "", Alpha3: "new", Name: "Nepal Bhasa; Newari"},
+	{Alpha2: "", Alpha3: "nia", Name: "Nias"},
+	{Alpha2: "", Alpha3: "nic", Name: "Niger-Kordofanian languages"},
+	{Alpha2: "", Alpha3: "niu", Name: "Niuean"},
+	{Alpha2: "nl", Alpha3: "nld", Name: "Dutch; Flemish"},
+	{Alpha2: "nn", Alpha3: "nno", Name: "Norwegian Nynorsk; Nynorsk, Norwegian"},
+	{Alpha2: "nb", Alpha3: "nob", Name: "Bokmål, Norwegian; Norwegian Bokmål"},
+	{Alpha2: "", Alpha3: "nog", Name: "Nogai"},
+	{Alpha2: "", Alpha3: "non", Name: "Norse, Old"},
+	{Alpha2: "no", Alpha3: "nor", Name: "Norwegian"},
+	{Alpha2: "", Alpha3: "nqo", Name: "N'Ko"},
+	{Alpha2: "", Alpha3: "nso", Name: "Pedi; Sepedi; Northern Sotho"},
+	{Alpha2: "", Alpha3: "nub", Name: "Nubian languages"},
+	{Alpha2: "", Alpha3: "nwc", Name: "Classical Newari; Old Newari; Classical Nepal Bhasa"},
+	{Alpha2: "ny", Alpha3: "nya", Name: "Chichewa; Chewa; Nyanja"},
+	{Alpha2: "", Alpha3: "nym", Name: "Nyamwezi"},
+	{Alpha2: "", Alpha3: "nyn", Name: "Nyankole"},
+	{Alpha2: "", Alpha3: "nyo", Name: "Nyoro"},
+	{Alpha2: "", Alpha3: "nzi", Name: "Nzima"},
+	{Alpha2: "oc", Alpha3: "oci", Name: "Occitan (post 1500); Provençal"},
+	{Alpha2: "oj", Alpha3: "oji", Name: "Ojibwa"},
+	{Alpha2: "or", Alpha3: "ori", Name: "Oriya"},
+	{Alpha2: "om", Alpha3: "orm", Name: "Oromo"},
+	{Alpha2: "", Alpha3: "osa", Name: "Osage"},
+	{Alpha2: "os", Alpha3: "oss", Name: "Ossetian; Ossetic"},
+	{Alpha2: "", Alpha3: "ota", Name: "Turkish, Ottoman (1500-1928)"},
+	{Alpha2: "", Alpha3: "oto", Name: "Otomian languages"},
+	{Alpha2: "", Alpha3: "paa", Name: "Papuan languages"},
+	{Alpha2: "", Alpha3: "pag", Name: "Pangasinan"},
+	{Alpha2: "", Alpha3: "pal", Name: "Pahlavi"},
+	{Alpha2: "", Alpha3: "pam", Name: "Pampanga; Kapampangan"},
+	{Alpha2: "pa", Alpha3: "pan", Name: "Panjabi; Punjabi"},
+	{Alpha2: "", Alpha3: "pap", Name: "Papiamento"},
+	{Alpha2: "", Alpha3: "pau", Name: "Palauan"},
+	{Alpha2: "", Alpha3: "peo", Name: "Persian, Old (ca. 600-400 B.C.)"},
+	{Alpha2: "", Alpha3: "phi", Name: "Philippine languages"},
+	{Alpha2: "", Alpha3: "phn", Name: "Phoenician"},
+	{Alpha2: "pi", Alpha3: "pli", Name: "Pali"},
+	{Alpha2: "pl", Alpha3: "pol", Name: "Polish"},
+	{Alpha2: "", Alpha3: "pon", Name: "Pohnpeian"},
+	{Alpha2: "pt", Alpha3: "por", Name: "Portuguese"},
+	{Alpha2: "", Alpha3: "pra", Name: "Prakrit languages"},
+	{Alpha2: "", Alpha3: "pro", Name: "Provençal, Old (to 1500)"},
+	{Alpha2: "ps", Alpha3: "pus", Name: "Pushto; Pashto"},
+	{Alpha2: "", Alpha3: "qaa-qtz", Name: "Reserved for local use"},
+	{Alpha2: "qu", Alpha3: "que", Name: "Quechua"},
+	{Alpha2: "", Alpha3: "raj", Name: "Rajasthani"},
+	{Alpha2: "", Alpha3: "rap", Name: "Rapanui"},
+	{Alpha2: "", Alpha3: "rar", Name: "Rarotongan; Cook Islands Maori"},
+	{Alpha2: "", Alpha3: "roa", Name: "Romance languages"},
+	{Alpha2: "rm", Alpha3: "roh", Name: "Romansh"},
+	{Alpha2: "", Alpha3: "rom", Name: "Romany"},
+	{Alpha2: "ro", Alpha3: "ron", Name: "Romanian; Moldavian; Moldovan"},
+	{Alpha2: "rn", Alpha3: "run", Name: "Rundi"},
+	{Alpha2: "", Alpha3: "rup", Name: "Aromanian; Arumanian; Macedo-Romanian"},
+	{Alpha2: "ru", Alpha3: "rus", Name: "Russian"},
+	{Alpha2: "", Alpha3: "sad", Name: "Sandawe"},
+	{Alpha2: "sg", Alpha3: "sag", Name: "Sango"},
+	{Alpha2: "", Alpha3: "sah", Name: "Yakut"},
+	{Alpha2: "", Alpha3: "sai", Name: "South American Indian (Other)"},
+	{Alpha2: "", Alpha3: "sal", Name: "Salishan languages"},
+	{Alpha2: "", Alpha3: "sam", Name: "Samaritan Aramaic"},
+	{Alpha2: "sa", Alpha3: "san", Name: "Sanskrit"},
+	{Alpha2: "", Alpha3: "sas", Name: "Sasak"},
+	{Alpha2: "", Alpha3: "sat", Name: "Santali"},
+	{Alpha2: "", Alpha3: "scn", Name: "Sicilian"},
+	{Alpha2: "", Alpha3: "sco", Name: "Scots"},
+	{Alpha2: "", Alpha3: "sel", Name: "Selkup"},
+	{Alpha2: "", Alpha3: "sem", Name: "Semitic languages"},
+	{Alpha2: "", Alpha3: "sga", Name: "Irish, Old (to 900)"},
+	{Alpha2: "", Alpha3: "sgn", Name: "Sign Languages"},
+	{Alpha2: "", Alpha3: "shn", Name: "Shan"},
+	{Alpha2: "", Alpha3: "sid", Name: "Sidamo"},
+	{Alpha2: "si", Alpha3: "sin", Name: "Sinhala; Sinhalese"},
+	{Alpha2: "", Alpha3: "sio", Name: "Siouan languages"},
+	{Alpha2: "", Alpha3: "sit", Name: "Sino-Tibetan languages"},
+	{Alpha2: "", Alpha3: "sla", Name: "Slavic languages"},
+	{Alpha2: "sk", Alpha3: "slk", Name: "Slovak"},
+	{Alpha2: "sl", Alpha3: "slv", Name: "Slovenian"},
+	{Alpha2: "", Alpha3: "sma", Name: "Southern Sami"},
+	{Alpha2: "se", Alpha3: "sme", Name: "Northern Sami"},
+	{Alpha2: "", Alpha3: "smi", Name: "Sami languages"},
+	{Alpha2: "", Alpha3: "smj", Name: "Lule Sami"},
+	{Alpha2: "", Alpha3: "smn", Name: "Inari Sami"},
+	{Alpha2: "sm", Alpha3: "smo", Name: "Samoan"},
+	{Alpha2: "", Alpha3: "sms", Name: "Skolt Sami"},
+	{Alpha2: "sn", Alpha3: "sna", Name: "Shona"},
+	{Alpha2: "sd", Alpha3: "snd", Name: "Sindhi"},
+	{Alpha2: "", Alpha3: "snk", Name: "Soninke"},
+	{Alpha2: "", Alpha3: "sog", Name: "Sogdian"},
+	{Alpha2: "so", Alpha3: "som", Name: "Somali"},
+	{Alpha2: "", Alpha3: "son", Name: "Songhai languages"},
+	{Alpha2: "st", Alpha3: "sot", Name: "Sotho, Southern"},
+	{Alpha2: "es", Alpha3: "spa", Name: "Spanish; Castilian"},
+	{Alpha2: "sq", Alpha3: "sqi", Name: "Albanian"},
+	{Alpha2: "sc", Alpha3: "srd", Name: "Sardinian"},
+	{Alpha2: "", Alpha3: "srn", Name: "Sranan Tongo"},
+	{Alpha2: "sr", Alpha3: "srp", Name: "Serbian"},
+	{Alpha2: "", Alpha3: "srr", Name: "Serer"},
+	{Alpha2: "", Alpha3: "ssa", Name: "Nilo-Saharan languages"},
+	{Alpha2: "ss", Alpha3: "ssw", Name: "Swati"},
+	{Alpha2: "", Alpha3: "suk", Name: "Sukuma"},
+	{Alpha2: "su", Alpha3: "sun", Name: "Sundanese"},
+	{Alpha2: "", Alpha3: "sus", Name: "Susu"},
+	{Alpha2: "", Alpha3: "sux", Name: "Sumerian"},
+	{Alpha2: "sw", Alpha3: "swa", Name: "Swahili"},
+	{Alpha2: "sv", Alpha3: "swe", Name: "Swedish"},
+	{Alpha2: "", Alpha3: "syc", Name: "Classical Syriac"},
+	{Alpha2: "", Alpha3: "syr", Name: "Syriac"},
+	{Alpha2: "ty", Alpha3: "tah", Name: "Tahitian"},
+	{Alpha2: "", Alpha3: "tai", Name: "Tai languages"},
+	{Alpha2: "ta", Alpha3: "tam", Name: "Tamil"},
+	{Alpha2: "tt", Alpha3: "tat", Name: "Tatar"},
+	{Alpha2: "te", Alpha3: "tel", Name: "Telugu"},
+	{Alpha2: "", Alpha3: "tem", Name: "Timne"},
+	{Alpha2: "", Alpha3: "ter", Name: "Tereno"},
+	{Alpha2: "", Alpha3: "tet", Name: "Tetum"},
+	{Alpha2: "tg", Alpha3: "tgk", Name: "Tajik"},
+	{Alpha2: "tl", Alpha3: "tgl", Name: "Tagalog"},
+	{Alpha2: "th", Alpha3: "tha", Name: "Thai"},
+	{Alpha2: "", Alpha3: "tig", Name: "Tigre"},
+	{Alpha2: "ti", Alpha3: "tir", Name: "Tigrinya"},
+	{Alpha2: "", Alpha3: "tiv", Name: "Tiv"},
+	{Alpha2: "", Alpha3: "tkl", Name: "Tokelau"},
+	{Alpha2: "", Alpha3: "tlh", Name: "Klingon; tlhIngan-Hol"},
+	{Alpha2: "", Alpha3: "tli", Name: "Tlingit"},
+	{Alpha2: "", Alpha3: "tmh", Name: "Tamashek"},
+	{Alpha2: "", Alpha3: "tog", Name: "Tonga (Nyasa)"},
+	{Alpha2: "to", Alpha3: "ton", Name: "Tonga (Tonga Islands)"},
+	{Alpha2: "", Alpha3: "tpi", Name: "Tok Pisin"},
+	{Alpha2: "", Alpha3: "tsi", Name: "Tsimshian"},
+	{Alpha2: "tn", Alpha3: "tsn", Name: "Tswana"},
+	{Alpha2: "ts", Alpha3: "tso", Name: "Tsonga"},
+	{Alpha2: "tk", Alpha3: "tuk", Name: "Turkmen"},
+	{Alpha2: "", Alpha3: "tum", Name: "Tumbuka"},
+	{Alpha2: "", Alpha3: "tup", Name: "Tupi languages"},
+	{Alpha2: "tr", Alpha3: "tur", Name: "Turkish"},
+	{Alpha2: "", Alpha3: "tut", Name: "Altaic languages"},
+	{Alpha2: "", Alpha3: "tvl", Name: "Tuvalu"},
+	{Alpha2: "tw", Alpha3: "twi", Name: "Twi"},
+	{Alpha2: "", Alpha3: "tyv", Name: "Tuvinian"},
+	{Alpha2: "", Alpha3: "udm", Name: "Udmurt"},
+	{Alpha2: "", Alpha3: "uga", Name: "Ugaritic"},
+	{Alpha2: "ug", Alpha3: "uig", Name: "Uighur; Uyghur"},
+	{Alpha2: "uk", Alpha3: "ukr", Name: "Ukrainian"},
+	{Alpha2: "", Alpha3: "umb", Name: "Umbundu"},
+	{Alpha2: "", Alpha3: "und", Name: "Undetermined"},
+	{Alpha2: "ur", Alpha3: "urd", Name: "Urdu"},
+	{Alpha2: "uz", Alpha3: "uzb", Name: "Uzbek"},
+	{Alpha2: "", Alpha3: "vai", Name: "Vai"},
+	{Alpha2: "ve", Alpha3: "ven", Name: "Venda"},
+	{Alpha2: "vi", Alpha3: "vie", Name: "Vietnamese"},
+	{Alpha2: "vo", Alpha3: "vol", Name: "Volapük"},
+	{Alpha2: "", Alpha3: "vot", Name: "Votic"},
+	{Alpha2: "", Alpha3: "wak", Name: "Wakashan languages"},
+	{Alpha2: "", Alpha3: "wal", Name: "Walamo"},
+	{Alpha2: "", Alpha3: "war", Name: "Waray"},
+	{Alpha2: "", Alpha3: "was", Name: "Washo"},
+	{Alpha2: "", Alpha3: "wen", Name: "Sorbian languages"},
+	{Alpha2: "wa", Alpha3: "wln", Name: "Walloon"},
+	{Alpha2: "wo", Alpha3: "wol", Name: "Wolof"},
+	{Alpha2: "", Alpha3: "xal", Name: "Kalmyk; Oirat"},
+	{Alpha2: "xh", Alpha3: "xho", Name: "Xhosa"},
+	{Alpha2: "", Alpha3: "yao", Name: "Yao"},
+	{Alpha2: "", Alpha3: "yap", Name: "Yapese"},
+	{Alpha2: "yi", Alpha3: "yid", Name: "Yiddish"},
+	{Alpha2: "yo", Alpha3: "yor", Name: "Yoruba"},
+	{Alpha2: "", Alpha3: "ypk", Name: "Yupik languages"},
+	{Alpha2: "", Alpha3: "zap", Name: "Zapotec"},
+	{Alpha2: "", Alpha3: "zbl", Name: "Blissymbols; Blissymbolics; Bliss"},
+	{Alpha2: "", Alpha3: "zen", Name: "Zenaga"},
+	{Alpha2: "", Alpha3: "zgh", Name: "Standard Moroccan Tamazight"},
+	{Alpha2: "za", Alpha3: "zha", Name: "Zhuang; Chuang"},
+	{Alpha2: "zh", Alpha3: "zho", Name: "Chinese"},
+	{Alpha2: "", Alpha3: "znd", Name: "Zande languages"},
+	{Alpha2: "zu", Alpha3: "zul", Name: "Zulu"},
+	{Alpha2: "", Alpha3: "zun", Name: "Zuni"},
+	{Alpha2: "", Alpha3: "zxx", Name: "No linguistic content; Not applicable"},
+	{Alpha2: "", Alpha3: "zza", Name: "Zaza; Dimili; Dimli; Kirdki; Kirmanjki; Zazaki"},
+}
+
+// languageCodes are the ISO 639-3 codes which are allowed in the language tags as well
+var languageCodes = `
+aaa aab aac aad aae aaf aag aah aai aak aal aan aao aap aaq aar aas aat aau aaw aax aaz aba abb abc abd abe abf abg abh
+abi abj abk abl abm abn abo abp abq abr abs abt abu abv abw abx aby abz aca acb acd ace acf ach aci ack acl acm acn acp
+acq acr acs act acu acv acw acx acy acz ada adb add ade adf adg adh adi adj adl adn ado adq adr ads adt adu adw adx ady
+adz aea aeb aec aed aee aek ael aem aen aeq aer aes aeu aew aey aez afb afd afe afg afh afi afk afn afo afp afr afs aft
+afu afz aga agb agc agd age agf agg agh agi agj agk agl agm agn ago agq agr ags agt agu agv agw agx agy agz aha ahb ahg
+ahh ahi ahk ahl ahm ahn aho ahp ahr ahs aht aia aib aic aid aie aif aig aih aii aij aik ail aim ain aio aip aiq air ait
+aiw aix aiy aja ajg aji ajn ajp ajs aju ajw ajz aka akb akc akd ake akf akg akh aki akj akk akl akm ako akp akq akr aks
+akt aku akv akw akx aky akz ala alc ald ale alf alh ali alj alk all alm aln alo alp alq alr als alt alu alw alx aly alz
+ama amb amc ame amf amg amh ami amj amk aml amm amn amo amp amq amr ams amt amu amv amw amx amy amz ana anb anc and ane
+anf ang anh ani anj ank anl anm ann ano anp anq anr ans ant anu anv anw anx any anz aoa aob aoc aod aoe aof aog aoi aoj
+aok aol aom aon aor aos aot aou aox aoz apb apc apd ape apf apg aph api apj apk apl apm apn apo app apq apr aps apt apu
+apv apw apx apy apz aqc aqd aqg aqk aqm aqn aqp aqr aqt aqz ara arb arc ard are arg arh ari arj ark arl arn aro arp arq
+arr ars aru arv arw arx ary arz asa asb asc ase asf asg ash asi asj ask asl asm asn aso asp asq asr ass ast asu asv asw
+asx asy asz ata atb atc atd ate atg ati atj atk atl atm atn ato atp atq atr ats att atu atv atw atx aty atz aua aub auc
+aud aug auh aui auj auk aul aum aun auo aup auq aur aut auu auw aux auy auz ava avb avd ave avi avk avl avm avn avo avs
+avt avu avv awa awb awc awe awg awh awi awk awm awn awo awr aws awt awu awv aww awx awy axb axe axg axk axl axm axx aya
+ayb ayc ayd aye ayg ayh ayi ayk ayl aym ayn ayo ayp ayq ayr ays ayt ayu ayz aza azb azd aze azg azj azm azn azo azt azz
+baa bab bac bae baf bag bah baj bak bal bam ban bao bap bar bas bau bav baw bax bay bba bbb bbc bbd bbe bbf bbg bbh bbi
+bbj bbk bbl bbm bbn bbo bbp bbq bbr bbs bbt bbu bbv bbw bbx bby bca bcb bcc bcd bce bcf bcg bch bci bcj bck bcl bcm bcn
+bco bcp bcq bcr bcs bct bcu bcv bcw bcy bcz bda bdb bdc bdd bde bdf bdg bdh bdi bdj bdk bdl bdm bdn bdo bdp bdq bdr bds
+bdt bdu bdv bdw bdx bdy bdz bea beb bec bed bee bef beg beh bei bej bek bel bem ben beo bep beq bes bet beu bev bew bex
+bey bez bfa bfb bfc bfd bfe bff bfg bfh bfi bfj bfk bfl bfm bfn bfo bfp bfq bfr bfs bft bfu bfw bfx bfy bfz bga bgb bgc
+bgd bge bgf bgg bgi bgj bgk bgl bgn bgo bgp bgq bgr bgs bgt bgu bgv bgw bgx bgy bgz bha bhb bhc bhd bhe bhf bhg bhh bhi
+bhj bhl bhm bhn bho bhp bhq bhr bhs bht bhu bhv bhw bhx bhy bhz bia bib bid bie bif big bik bil bim bin bio bip biq bir
+bis bit biu biv biw bix biy biz bja bjb bjc bje bjf bjg bjh bji bjj bjk bjl bjm bjn bjo bjp bjr bjs bjt bju bjv bjw bjx
+bjy bjz bka bkc bkd bkf bkg bkh bki bkj bkk bkl bkm bkn bko bkp bkq bkr bks bkt bku bkv bkw bkx bky bkz bla blb blc bld
+ble blf blh bli blj blk bll blm bln blo blp blq blr bls blt blv blw blx bly blz bma bmb bmc bmd bme bmf bmg bmh bmi bmj
+bmk bml bmm bmn bmo bmp bmq bmr bms bmt bmu bmv bmw bmx bmz bna bnb bnc bnd bne bnf bng bni bnj bnk bnl bnm bnn bno bnp
+bnq bnr bns bnu bnv bnw bnx bny bnz boa bob bod boe bof bog boh boi boj bok bol bom bon boo bop boq bor bos bot bou bov
+bow box boy boz bpa bpc bpd bpe bpg bph bpi bpj bpk bpl bpm bpn bpo bpp bpq bpr bps bpt bpu bpv bpw bpx bpy bpz bqa bqb
+bqc bqd bqf bqg bqh bqi bqj bqk bql bqm bqn bqo bqp bqq bqr bqs bqt bqu bqv bqw bqx bqy bqz bra brb brc brd bre brf brg
+brh bri brj brk brl brm brn bro brp brq brr brs brt bru brv brw brx bry brz bsa bsb bsc bse bsf bsg bsh bsi bsj bsk bsl
+bsm bsn bso bsp bsq bsr bss bst bsu bsv bsw bsx bsy bta btc btd bte btf btg bth bti btj btm btn bto btp btq btr bts btt
+btu btv btw btx bty btz bua bub buc bud bue buf bug buh bui buj buk bul bum bun buo bup buq bus but buu buv buw bux buy
+buz bva bvb bvc bvd bve bvf bvg bvh bvi bvj bvk bvl bvm bvn bvo bvp bvq bvr bvt bvu bvv bvw bvx bvy bvz bwa bwb bwc bwd
+bwe bwf bwg bwh bwi bwj bwk bwl bwm bwn bwo bwp bwq bwr bws bwt bwu bww bwx bwy bwz bxa bxb bxc bxd bxe bxf bxg bxh bxi
+bxj bxk bxl bxm bxn bxo bxp bxq bxr bxs bxu bxv bxw bxz bya byb byc byd bye byf byg byh byi byj byk byl bym byn byo byp
+byq byr bys byt byv byw byx byz bza bzb bzc bzd bze bzf bzg bzh bzi bzj bzk bzl bzm bzn bzo bzp bzq bzr bzs bzt bzu bzv
+bzw bzx bzy bzz caa cab cac cad cae caf cag cah caj cak cal cam can cao cap caq car cas cat cav caw cax cay caz cbb cbc
+cbd cbg cbi cbj cbk cbl cbn cbo cbq cbr cbs cbt cbu cbv cbw cby ccc ccd cce ccg cch ccj ccl ccm cco ccp ccr cda cde cdf
+cdh cdi cdj cdm cdn cdo cdr cds cdy cdz cea ceb ceg cek cen ces cet cey cfa cfd cfg cfm cga cgc cgg cgk cha chb chc chd
+che chf chg chh chj chk chl chm chn cho chp chq chr cht chu chv chw chx chy chz cia cib cic cid cie cih cik cim cin cip
+cir ciw ciy cja cje cjh cji cjk cjm cjn cjo cjp cjs cjv cjy ckb ckh ckl ckm ckn cko ckq ckr cks ckt cku ckv ckx cky ckz
+cla clc cld cle clh cli clj clk cll clm clo clt clu clw cly cma cme cmg cmi cml cmm cmn cmo cmr cms cmt cna cnb cnc cng
+cnh cni cnk cnl cno cnp cnq cnr cns cnt cnu cnw cnx coa cob coc cod coe cof cog coh coj cok col com con coo cop coq cor
+cos cot cou cov cow cox coz cpa cpb cpc cpg cpi cpn cpo cps cpu cpx cpy cqd cra crb crc crd cre crf crg crh cri crj crk
+crl crm crn cro crq crr crs crt crv crw crx cry crz csa csb csc csd cse csf csg csh csi csj csk csl csm csn cso csp csq
+csr css cst csv csw csx csy csz cta ctc ctd cte ctg cth ctl ctm ctn cto ctp cts ctt ctu cty ctz cua cub cuc cuh cui cuj
+cuk cul cuo cup cuq cur cut cuu cuv cuw cux cuy cvg cvn cwa cwb cwd cwe cwg cwt cya cyb cym cyo czh czk czn czo czt daa
+dac dad dae dag dah dai daj dak dal dam dan dao daq dar das dau dav daw dax daz dba dbb dbd dbe dbf dbg dbi dbj dbl dbm
+dbn dbo dbp dbq dbr dbt dbu dbv dbw dby dcc dcr dda ddd dde ddg ddi ddj ddn ddo ddr dds ddw dec ded dee def deg deh dei
+dek del dem den dep deq der des deu dev dez dga dgb dgc dgd dge dgg dgh dgi dgk dgl dgn dgo dgr dgs dgt dgw dgx dgz dhd
+dhg dhi dhl dhm dhn dho dhr dhs dhu dhv dhw dhx dia dib dic did dif dig dih dii dij dik dil dim din dio dip diq dir dis
+diu div diw dix diy diz dja djb djc djd dje djf dji djj djk djm djn djo djr dju djw dka dkg dkk dkr dks dkx dlg dlk dlm
+dln dma dmb dmc dmd dme dmf dmg dmk dml dmm dmo dmr dms dmu dmv dmw dmx dmy dna dnd dne dng dni dnj dnk dnn dno dnr dnt
+dnu dnv dnw dny doa dob doc doe dof doh doi dok dol don doo dop doq dor dos dot dov dow dox doy doz dpp drb drc drd dre
+drg dri drl drn dro drq drs drt dru dry dsb dse dsh dsi dsl dsn dso dsq dsz dta dtb dtd dth dti dtk dtm dtn dto dtp dtr
+dts dtt dtu dty dua dub duc due duf dug duh dui duk dul dum dun duo dup duq dur dus duu duv duw dux duy duz dva dwa dwk
+dwr dws dwu dww dwy dwz dya dyb dyd dyg dyi dym dyn dyo dyu dyy dza dze dzg dzl dzn dzo eaa ebc ebg ebk ebo ebr ebu ecr
+ecs ecy eee efa efe efi ega egl egm ego egy ehs ehu eip eit eiv eja eka eke ekg eki ekk ekl ekm eko ekp ekr eky ele elh
+eli elk ell elm elo elu elx ema emb eme emg emi emk emm emn emp emq ems emu emw emx emy emz ena enb enc end enf eng enh
+enl enm enn eno enq enr enu env enw enx eot epi epo era erg erh eri erk ero err ers ert erw ese esg esh esi esk esl esm
+esn eso esq ess est esu esy etb etc eth etn eto etr ets ett etu etx etz eus eve evh evn ewe ewo ext eya eyo eza eze faa
+fab fad faf fag fah fai faj fak fal fam fan fao fap far fas fat fau fax fay faz fbl fcs fer ffi ffm fgr fia fie fif fij
+fil fin fip fir fit fiw fkk fkv fla flh fli fll fln flr fly fmp fmu fnb fng fni fod foi fom fon for fos fpe fqs fra frc
+frd frk frm fro frp frq frr frs frt fry fse fsl fss fub fuc fud fue fuf fuh fui fuj ful fum fun fuq fur fut fuu fuv fuy
+fvr fwa fwe gaa gab gac gad gae gaf gag gah gai gaj gak gal gam gan gao gap gaq gar gas gat gau gaw gax gay gaz gba gbb
+gbd gbe gbf gbg gbh gbi gbj gbk gbl gbm gbn gbo gbp gbq gbr gbs gbu gbv gbw gbx gby gbz gcc gcd gce gcf gcl gcn gcr gct
+gda gdb gdc gdd gde gdf gdg gdh gdi gdj gdk gdl gdm gdn gdo gdq gdr gds gdt gdu gdx gea geb gec ged gef geg geh gei gej
+gek gel geq ges gev gew gex gey gez gfk gft gga ggb ggd gge ggg ggk ggl ggt ggu ggw gha ghc ghe ghh ghk ghl ghn gho ghr
+ghs ght gia gib gic gid gie gig gih gii gil gim gin gip giq gir gis git giu giw gix giy giz gjk gjm gjn gjr gju gka gkd
+gke gkn gko gkp gku gla glb glc gld gle glg glh glj glk gll glo glr glu glv glw gly gma gmb gmd gmg gmh gml gmm gmn gmr
+gmu gmv gmx gmy gmz gna gnb gnc gnd gne gng gnh gni gnj gnk gnl gnm gnn gno gnq gnr gnt gnu gnw gnz goa gob goc god goe
+gof gog goh goi goj gok gol gom gon goo gop goq gor gos got gou gov gow gox goy goz gpa gpe gpn gqa gqi gqn gqr gqu gra
+grb grc grd grg grh gri grj grm grn gro grq grr grs grt gru grv grw grx gry grz gse gsg gsl gsm gsn gso gsp gss gsw gta
+gtu gua gub guc gud gue guf gug guh gui guj guk gul gum gun guo gup guq gur gus gut guu guw gux guz gva gvc gve gvf gvj
+gvl gvm gvn gvo gvp gvr gvs gvy gwa gwb gwc gwd gwe gwf gwg gwi gwj gwm gwn gwr gwt gwu gww gwx gxx gya gyb gyd gye gyf
+gyg gyi gyl gym gyn gyo gyr gyy gyz gza gzi gzn haa hab hac had hae haf hag hah hai haj hak hal ham han hao hap haq har
+has hat hau hav haw hax hay haz hba hbb hbn hbo hbs hbu hca hch hdn hds hdy hea heb hed heg heh hei hem her hgm hgw hhi
+hhr hhy hia hib hid hif hig hih hii hij hik hil hin hio hir hit hiw hix hji hka hke hkh hkk hkn hks hla hlb hld hle hlt
+hlu hma hmb hmc hmd hme hmf hmg hmh hmi hmj hmk hml hmm hmn hmo hmp hmq hmr hms hmt hmu hmv hmw hmy hmz hna hnd hne hng
+hnh hni hnj hnn hno hns hnu hoa hob hoc hod hoe hoh hoi hoj hol hom hoo hop hor hos hot hov how hoy hoz hpo hps hra hrc
+hre hrk hrm hro hrp hrt hru hrv hrw hrx hrz hsb hsh hsl hsn hss hti hto hts htu htx hub huc hud hue huf hug huh hui huj
+huk hul hum hun huo hup huq hur hus hut huu huv huw hux huy huz hvc hve hvk hvn hvv hwa hwc hwo hya hye hyw iai ian iar
+iba ibb ibd ibe ibg ibh ibl ibm ibn ibo ibr ibu iby ica ich icl icr ida idb idc idd ide idi ido idr ids idt idu ifa ifb
+ife iff ifk ifm ifu ify igb ige igg igl igm ign igo igs igw ihb ihi ihp ihw iii iin ijc ije ijj ijn ijs ike iki ikk ikl
+iko ikp ikr iks ikt iku ikv ikw ikx ikz ila ilb ile ilg ili ilk ilm ilo ilp ils ilu ilv ima imi iml imn imo imr ims imt
+imy ina inb ind ing inh inj inl inm inn ino inp ins int inz ior iou iow ipi ipk ipo iqu iqw ire irh iri irk irn irr iru
+irx iry isa isc isd ise isg ish isi isk isl ism isn iso isr ist isu ita itb itd ite iti itk itl itm ito itr its itt itv
+itw itx ity itz ium ivb ivv iwk iwm iwo iws ixc ixl iya iyo iyx izh izr izz jaa jab jac jad jae jaf jah jaj jak jal jam
+jan jao jaq jas jat jau jav jax jay jaz jbe jbi jbj jbk jbm jbn jbo jbr jbt jbu jbw jcs jct jda jdg jdt jeb jee jeh jei
+jek jel jen jer jet jeu jgb jge jgk jgo jhi jhs jia jib jic jid jie jig jih jii jil jim jio jiq jit jiu jiv jiy jje jjr
+jka jkm jko jkp jkr jks jku jle jls jma jmb jmc jmd jmi jml jmn jmr jms jmw jmx jna jnd jng jni jnj jnl jns job jod jog
+jor jos jow jpa jpn jpr jqr jra jrb jrr jrt jru jsl jua jub juc jud juh jui juk jul jum jun juo jup jur jus jut juu juw
+juy jvd jvn jwi jya jye jyy kaa kab kac kad kae kaf kag kah kai kaj kak kal kam kan kao kap kaq kas kat kau kav kaw kax
+kay kaz kba kbb kbc kbd kbe kbg kbh kbi kbj kbk kbl kbm kbn kbo kbp kbq kbr kbs kbt kbu kbv kbw kbx kby kbz kca kcb kcc
+kcd kce kcf kcg kch kci kcj kck kcl kcm kcn kco kcp kcq kcr kcs kct kcu kcv kcw kcx kcy kcz kda kdc kdd kde kdf kdg kdh
+kdi kdj kdk kdl kdm kdn kdp kdq kdr kdt kdu kdw kdx kdy kdz kea keb kec ked kee kef keg keh kei kej kek kel kem ken keo
+kep keq ker kes ket keu kev kew kex key kez kfa kfb kfc kfd kfe kff kfg kfh kfi kfj kfk kfl kfm kfn kfo kfp kfq kfr kfs
+kft kfu kfv kfw kfx kfy kfz kga kgb kge kgf kgg kgi kgj kgk kgl kgm kgn kgo kgp kgq kgr kgs kgt kgu kgv kgw kgx kgy kha
+khb khc khd khe khf khg khh khj khk khl khm khn kho khp khq khr khs kht khu khv khw khx khy khz kia kib kic kid kie kif
+kig kih kii kij kik kil kim kin kio kip kiq kir kis kit kiu kiv kiw kix kiy kiz kja kjb kjc kjd kje kjg kjh kji kjj kjk
+kjl kjm kjn kjo kjp kjq kjr kjs kjt kju kjv kjx kjy kjz kka kkb kkc kkd kke kkf kkg kkh kki kkj kkk kkl kkm kkn kko kkp
+kkq kkr kks kkt kku kkv kkw kkx kky kkz kla klb klc kld kle klf klg klh kli klj klk kll klm kln klo klp klq klr kls klt
+klu klv klw klx kly klz kma kmb kmc kmd kme kmf kmg kmh kmi kmj kmk kml kmm kmn kmo kmp kmq kmr kms kmt kmu kmv kmw kmx
+kmy kmz kna knb knc knd kne knf kng kni knj knk knl knm knn kno knp knq knr kns knt knu knv knw knx kny knz koa koc kod
+koe kof kog koh koi kok kol kom kon koo kop koq kor kos kot kou kov kow koy koz kpa kpb kpc kpd kpe kpf kpg kph kpi kpj
+kpk kpl kpm kpn kpo kpq kpr kps kpt kpu kpv kpw kpx kpy kpz kqa kqb kqc kqd kqe kqf kqg kqh kqi kqj kqk kql kqm kqn kqo
+kqp kqq kqr kqs kqt kqu kqv kqw kqx kqy kqz kra krb krc krd kre krf krh kri krj krk krl krn krp krr krs krt kru krv krw
+krx kry krz ksa ksb ksc ksd kse ksf ksg ksh ksi ksj ksk ksl ksm ksn kso ksp ksq ksr kss kst ksu ksv ksw ksx ksy ksz kta
+ktb ktc ktd kte ktf ktg kth kti ktj ktk ktl ktm ktn kto ktp ktq kts ktt ktu ktv ktw ktx kty ktz kua kub kuc kud kue kuf
+kug kuh kui kuj kuk kul kum kun kuo kup kuq kur kus kut kuu kuv kuw kux kuy kuz kva kvb kvc kvd kve kvf kvg kvh kvi kvj
+kvk kvl kvm kvn kvo kvp kvq kvr kvt kvu kvv kvw kvx kvy kvz kwa kwb kwc kwd kwe kwf kwg kwh kwi kwj kwk kwl kwm kwn kwo
+kwp kwr kws kwt kwu kwv kww kwx kwy kwz kxa kxb kxc kxd kxf kxh kxi kxj kxk kxm kxn kxo kxp kxq kxr kxs kxt kxv kxw kxx
+kxy kxz kya kyb kyc kyd kye kyf kyg kyh kyi kyj kyk kyl kym kyn kyo kyp kyq kyr kys kyt kyu kyv kyw kyx kyy kyz kza kzb
+kzc kzd kze kzf kzg kzi kzk kzl kzm kzn kzo kzp kzq kzr kzs kzu kzv kzw kzx kzy kzz laa lab lac lad lae laf lag lah lai
+laj lal lam lan lao lap laq lar las lat lau lav law lax lay laz lbb lbc lbe lbf lbg lbi lbj lbk lbl lbm lbn lbo lbq lbr
+lbs lbt lbu lbv lbw lbx lby lbz lcc lcd lce lcf lch lcl lcm lcp lcq lcs lda ldb ldd ldg ldh ldi ldj ldk ldl ldm ldn ldo
+ldp ldq lea leb lec led lee lef leh lei lej lek lel lem len leo lep leq ler les let leu lev lew lex ley lez lfa lfn lga
+lgb lgg lgh lgi lgk lgl lgm lgn lgo lgq lgr lgt lgu lgz lha lhh lhi lhl lhm lhn lhp lhs lht lhu lia lib lic lid lie lif
+lig lih lij lik lil lim lin lio lip liq lir lis lit liu liv liw lix liy liz lja lje lji ljl ljp ljw ljx lka lkb lkc lkd
+lke lkh lki lkj lkl lkm lkn lko lkr lks lkt lku lky lla llb llc lld lle llf llg llh lli llj llk lll llm lln llp llq lls
+llu llx lma lmb lmc lmd lme lmf lmg lmh lmi lmj lmk lml lmn lmo lmp lmq lmr lmu lmv lmw lmx lmy lna lnb lnd lng lnh lni
+lnj lnl lnm lnn lns lnu lnw lnz loa lob loc loe lof log loh loi loj lok lol lom lon loo lop loq lor los lot lou lov low
+lox loy loz lpa lpe lpn lpo lpx lqr lra lrc lre lrg lri lrk lrl lrm lrn lro lrr lrt lrv lrz lsa lsb lsc lsd lse lsh lsi
+lsl lsm lsn lso lsp lsr lss lst lsv lsw lsy ltc ltg lth lti ltn lto lts ltu ltz lua lub luc lud lue luf lug lui luj luk
+lul lum lun luo lup luq lur lus lut luu luv luw luy luz lva lvi lvk lvs lvu lwa lwe lwg lwh lwl lwm lwo lws lwt lwu lww
+lxm lya lyg lyn lzh lzl lzn lzz maa mab mad mae maf mag mah mai maj mak mal mam man maq mar mas mat mau mav maw max maz
+mba mbb mbc mbd mbe mbf mbh mbi mbj mbk mbl mbm mbn mbo mbp mbq mbr mbs mbt mbu mbv mbw mbx mby mbz mca mcb mcc mcd mce
+mcf mcg mch mci mcj mck mcl mcm mcn mco mcp mcq mcr mcs mct mcu mcv mcw mcx mcy mcz mda mdb mdc mdd mde mdf mdg mdh mdi
+mdj mdk mdl mdm mdn mdp mdq mdr mds mdt mdu mdv mdw mdx mdy mdz mea meb mec med mee mef meh mei mej mek mel mem men meo
+mep meq mer mes met meu mev mew mey mez mfa mfb mfc mfd mfe mff mfg mfh mfi mfj mfk mfl mfm mfn mfo mfp mfq mfr mfs mft
+mfu mfv mfw mfx mfy mfz mga mgb mgc mgd mge mgf mgg mgh mgi mgj mgk mgl mgm mgn mgo mgp mgq mgr mgs mgt mgu mgv mgw mgy
+mgz mha mhb mhc mhd mhe mhf mhg mhi mhj mhk mhl mhm mhn mho mhp mhq mhr mhs mht mhu mhw mhx mhy mhz mia mib mic mid mie
+mif mig mih mii mij mik mil mim min mio mip miq mir mis mit miu miw mix miy miz mjb mjc mjd mje mjg mjh mji mjj mjk mjl
+mjm mjn mjo mjp mjq mjr mjs mjt mju mjv mjw mjx mjy mjz mka mkb mkc mkd mke mkf mkg mki mkj mkk mkl mkm mkn mko mkp mkq
+mkr mks mkt mku mkv mkw mkx mky mkz mla mlb mlc mle mlf mlg mlh mli mlj mlk mll mlm mln mlo mlp mlq mlr mls mlt mlu mlv
+mlw mlx mlz mma mmb mmc mmd mme mmf mmg mmh mmi mmj mmk mml mmm mmn mmo mmp mmq mmr mmt mmu mmv mmw mmx mmy mmz mna mnb
+mnc mnd mne mnf mng mnh mni mnj mnk mnl mnm mnn mnp mnq mnr mns mnu mnv mnw mnx mny mnz moa moc mod moe mog moh moi moj
+mok mom mon moo mop moq mor mos mot mou mov mow mox moy moz mpa mpb mpc mpd mpe mpg mph mpi mpj mpk mpl mpm mpn mpo mpp
+mpq mpr mps mpt mpu mpv mpw mpx mpy mpz mqa mqb mqc mqe mqf mqg mqh mqi mqj mqk mql mqm mqn mqo mqp mqq mqr mqs mqt mqu
+mqv mqw mqx mqy mqz mra mrb mrc mrd mre mrf mrg mrh mri mrj mrk mrl mrm mrn mro mrp mrq mrr mrs mrt mru mrv mrw mrx mry
+mrz msa msb msc msd mse msf msg msh msi msj msk msl msm msn mso msp msq msr mss msu msv msw msx msy msz mta mtb mtc mtd
+mte mtf mtg mth mti mtj mtk mtl mtm mtn mto mtp mtq mtr mts mtt mtu mtv mtw mtx mty mua mub muc mud mue mug muh mui muj
+muk mul mum muo mup muq mur mus mut muu muv mux muy muz mva mvb mvd mve mvf mvg mvh mvi mvk mvl mvn mvo mvp mvq mvr mvs
+mvt mvu mvv mvw mvx mvy mvz mwa mwb mwc mwe mwf mwg mwh mwi mwk mwl mwm mwn mwo mwp mwq mwr mws mwt mwu mwv mww mwz mxa
+mxb mxc mxd mxe mxf mxg mxh mxi mxj mxk mxl mxm mxn mxo mxp mxq mxr mxs mxt mxu mxv mxw mxx mxy mxz mya myb myc mye myf
+myg myh myj myk myl mym myo myp myr mys myu myv myw myx myy myz mza mzb mzc mzd mze mzg mzh mzi mzj mzk mzl mzm mzn mzo
+mzp mzq mzr mzs mzt mzu mzv mzw mzx mzy mzz naa nab nac nae naf nag naj nak nal nam nan nao nap naq nar nas nat nau nav
+naw nax nay naz nba nbb nbc nbd nbe nbg nbh nbi nbj nbk nbl nbm nbn nbo nbp nbq nbr nbs nbt nbu nbv nbw nby nca ncb ncc
+ncd nce ncf ncg nch nci ncj nck ncl ncm ncn nco ncq ncr ncs nct ncu ncx ncz nda ndb ndc ndd nde ndf ndg ndh ndi ndj ndk
+ndl ndm ndn ndo ndp ndq ndr nds ndt ndu ndv ndw ndx ndy ndz nea neb nec ned nee nef neg neh nei nej nek nem nen neo nep
+neq ner nes net neu nev new nex ney nez nfa nfd nfl nfr nfu nga ngb ngc ngd nge ngg ngh ngi ngj ngk ngl ngm ngn ngp ngq
+ngr ngs ngt ngu ngv ngw ngx ngy ngz nha nhb nhc nhd nhe nhf nhg nhh nhi nhk nhm nhn nho nhp nhq nhr nht nhu nhv nhw nhx
+nhy nhz nia nib nid nie nif nig nih nii nij nik nil nim nin nio niq nir nis nit niu niv niw nix niy niz nja njb njd njh
+nji njj njl njm njn njo njr njs njt nju njx njy njz nka nkb nkc nkd nke nkf nkg nkh nki nkj nkk nkm nkn nko nkp nkq nkr
+nks nkt nku nkv nkw nkx nkz nla nlc nld nle nlg nli nlj nlk nll nlm nlo nlq nlu nlv nlw nlx nly nlz nma nmb nmc nmd nme
+nmf nmg nmh nmi nmj nmk nml nmm nmn nmo nmp nmq nmr nms nmt nmu nmv nmw nmx nmy nmz nna nnb nnc nnd nne nnf nng nnh nni
+nnj nnk nnl nnm nnn nno nnp nnq nnr nnt nnu nnv nnw nny nnz noa nob noc nod noe nof nog noh noi noj nok nol nom non nop
+noq nor nos not nou nov now noy noz npa npb npg nph npi npl npn npo nps npu npx npy nqg nqk nql nqm nqn nqo nqq nqt nqy
+nra nrb nrc nre nrf nrg nri nrk nrl nrm nrn nrp nrr nrt nru nrx nrz nsa nsb nsc nsd nse nsf nsg nsh nsi nsk nsl nsm nsn
+nso nsp nsq nsr nss nst nsu nsv nsw nsx nsy nsz ntd nte ntg nti ntj ntk ntm nto ntp ntr ntu ntw ntx nty ntz nua nuc nud
+nue nuf nug nuh nui nuj nuk nul num nun nuo nup nuq nur nus nut nuu nuv nuw nux nuy nuz nvh nvm nvo nwa nwb nwc nwe nwg
+nwi nwm nwo nwr nww nwx nwy nxa nxd nxe nxg nxi nxk nxl nxm nxn nxo nxq nxr nxx nya nyb nyc nyd nye nyf nyg nyh nyi nyj
+nyk nyl nym nyn nyo nyp nyq nyr nys nyt nyu nyv nyw nyx nyy nza nzb nzd nzi nzk nzm nzs nzu nzy nzz oaa oac oar oav obi
+obk obl obm obo obr obt obu oca och oci ocm oco ocu oda odk odt odu ofo ofs ofu ogb ogc oge ogg ogo ogu oht ohu oia oie
+oin ojb ojc ojg oji ojp ojs ojv ojw oka okb okc okd oke okg okh oki okj okk okl okm okn oko okr oks oku okv okx okz ola
+old ole olk olm olo olr olt olu oma omb omc omg omi omk oml omn omo omp omr omt omu omw omx omy ona onb one ong oni onj
+onk onn ono onp onr ons ont onu onw onx ood oog oon oor oos opa opk opm opo opt opy ora orc ore org orh ori orm orn oro
+orr ors ort oru orv orw orx ory orz osa osc osi osn oso osp oss ost osu osx ota otb otd ote oti otk otl otm otn otq otr
+ots ott otu otw otx oty otz oua oub oue oui oum ovd owi owl oyb oyd oym oyy ozm pab pac pad pae paf pag pah pai pak pal
+pam pan pao pap paq par pas pau pav paw pax pay paz pbb pbc pbe pbf pbg pbh pbi pbl pbm pbn pbo pbp pbr pbs pbt pbu pbv
+pby pca pcb pcc pcd pce pcf pcg pch pci pcj pck pcl pcm pcn pcp pcw pda pdc pdi pdn pdo pdt pdu pea peb ped pee pef peg
+peh pei pej pek pel pem peo pep peq pes pev pex pey pez pfa pfe pfl pga pgd pgg pgi pgk pgl pgn pgs pgu pgz pha phd phg
+phh phj phk phl phm phn pho phq phr pht phu phv phw pia pib pic pid pie pif pig pih pij pil pim pin pio pip pir pis pit
+piu piv piw pix piy piz pjt pka pkb pkc pkg pkh pkn pko pkp pkr pks pkt pku pla plb plc pld ple plg plh pli plj plk pll
+pln plo plq plr pls plt plu plv plw ply plz pma pmb pmd pme pmf pmh pmi pmj pmk pml pmm pmn pmo pmq pmr pms pmt pmw pmx
+pmy pmz pna pnb pnc pnd pne png pnh pni pnj pnk pnl pnm pnn pno pnp pnq pnr pns pnt pnu pnv pnw pnx pny pnz poc poe pof
+pog poh poi pok pol pom pon poo pop poq por pos pot pov pow pox poy ppe ppi ppk ppl ppm ppn ppo ppp ppq pps ppt ppu pqa
+pqm prc prd pre prf prg prh pri prk prl prm prn pro prp prq prr prs prt pru prw prx prz psa psc psd pse psg psh psi psl
+psm psn pso psp psq psr pss pst psu psw psy pta pth pti ptn pto ptp ptq ptr ptt ptu ptv ptw pty pua pub puc pud pue puf
+pug pui puj pum puo pup puq pur pus put puu puw pux puy pwa pwb pwg pwi pwm pwn pwo pwr pww pxm pye pym pyn pys pyu pyx
+pyy pzh pzn qua qub quc qud que quf qug quh qui quk qul qum qun qup quq qur qus quv quw qux quy quz qva qvc qve qvh qvi
+qvj qvl qvm qvn qvo qvp qvs qvw qvy qvz qwa qwc qwh qwm qws qwt qxa qxc qxh qxl qxn qxo qxp qxq qxr qxs qxt qxu qxw qya
+qyp raa rab rac rad raf rag rah rai raj rak ral ram ran rao rap raq rar ras rat rau rav raw rax ray raz rbb rbk rbl rbp
+rcf rdb rea reb ree reg rei rej rel rem ren rer res ret rey rga rge rgk rgn rgr rgs rgu rhg rhp ria rib rif ril rim rin
+rir rit riu rjg rji rjs rka rkb rkh rki rkm rkt rkw rma rmb rmc rmd rme rmf rmg rmh rmi rmk rml rmm rmn rmo rmp rmq rms
+rmt rmu rmv rmw rmx rmy rmz rnb rnd rng rnl rnn rnp rnr rnw rob roc rod roe rof rog roh rol rom ron roo rop ror rou row
+rpn rpt rri rro rrt rsb rsk rsl rsm rsn rtc rth rtm rts rtw rub ruc rue ruf rug ruh rui ruk run ruo rup ruq rus rut ruu
+ruy ruz rwa rwk rwl rwm rwo rwr rxd rxw ryn rys ryu rzh saa sab sac sad sae saf sag sah saj sak sam san sao saq sar sas
+sat sau sav saw sax say saz sba sbb sbc sbd sbe sbf sbg sbh sbi sbj sbk sbl sbm sbn sbo sbp sbq sbr sbs sbt sbu sbv sbw
+sbx sby sbz scb sce scf scg sch sci sck scl scn sco scp scq scs sct scu scv scw scx sda sdb sdc sde sdf sdg sdh sdj sdk
+sdl sdn sdo sdp sdq sdr sds sdt sdu sdx sdz sea seb sec sed see sef seg seh sei sej sek sel sen seo sep seq ser ses set
+seu sev sew sey sez sfb sfe sfm sfs sfw sga sgb sgc sgd sge sgg sgh sgi sgj sgk sgm sgp sgr sgs sgt sgu sgw sgx sgy sgz
+sha shb shc shd she shg shh shi shj shk shl shm shn sho shp shq shr shs sht shu shv shw shx shy shz sia sib sid sie sif
+sig sih sii sij sik sil sim sin sip siq sir sis siu siv siw six siy siz sja sjb sjd sje sjg sjk sjl sjm sjn sjo sjp sjr
+sjs sjt sju sjw ska skb skc skd ske skf skg skh ski skj skm skn sko skp skq skr sks skt sku skv skw skx sky skz slc sld
+sle slf slg slh sli slj slk sll slm sln slp slq slr sls slt slu slv slw slx sly slz sma smb smc sme smf smg smh smj smk
+sml smm smn smo smp smq smr sms smt smu smv smw smx smy smz sna snc snd sne snf sng sni snj snk snl snm snn sno snp snq
+snr sns snu snv snw snx sny snz soa sob soc sod soe sog soh soi soj sok sol som soo sop soq sor sos sot sou sov sow sox
+soy soz spa spb spc spd spe spg spi spk spl spm spn spo spp spq spr sps spt spu spv spx spy sqa sqh sqi sqk sqm sqn sqo
+sqq sqr sqs sqt squ sqx sra srb src srd sre srf srg srh sri srk srl srm srn sro srp srq srr srs srt sru srv srw srx sry
+srz ssb ssc ssd sse ssf ssg ssh ssi ssj ssk ssl ssm ssn sso ssp ssq ssr sss sst ssu ssv ssw ssx ssy ssz sta stb std ste
+stf stg sth sti stj stk stl stm stn sto stp stq str sts stt stu stv stw sty sua sub suc sue sug sui suj suk sun suo suq
+sur sus sut suv suw sux suy suz sva svb svc sve svk svm svs svx swa swb swc swe swf swg swh swi swj swk swl swm swn swo
+swp swq swr sws swt swu swv sww swx swy sxb sxc sxe sxg sxk sxl sxm sxn sxo sxr sxs sxu sxw sya syb syc syi syk syl sym
+syn syo syr sys syw syx syy sza szb szc szd sze szg szl szn szp szs szv szw szy taa tab tac tad tae taf tag tah taj tak
+tal tam tan tao tap taq tar tas tat tau tav taw tax tay taz tba tbc tbd tbe tbf tbg tbh tbi tbj tbk tbl tbm tbn tbo tbp
+tbr tbs tbt tbu tbv tbw tbx tby tbz tca tcb tcc tcd tce tcf tcg tch tci tck tcl tcm tcn tco tcp tcq tcs tct tcu tcw tcx
+tcy tcz tda tdb tdc tdd tde tdf tdg tdh tdi tdj tdk tdl tdm tdn tdo tdq tdr tds tdt tdv tdx tdy tea teb tec ted tee tef
+teg teh tei tek tel tem ten teo tep teq ter tes tet teu tev tew tex tey tez tfi tfn tfo tfr tft tga tgb tgc tgd tge tgf
+tgh tgi tgj tgk tgl tgn tgo tgp tgq tgr tgs tgt tgu tgv tgw tgx tgy tgz tha thd the thf thh thi thk thl thm thn thp thq
+thr ths tht thu thv thy thz tia tic tif tig tih tii tij tik til tim tin tio tip tiq tir tis tit tiu tiv tiw tix tiy tiz
+tja tjg tji tjj tjl tjm tjn tjo tjp tjs tju tjw tka tkb tkd tke tkf tkg tkl tkm tkn tkp tkq tkr tks tkt tku tkv tkw tkx
+tkz tla tlb tlc tld tlf tlg tlh tli tlj tlk tll tlm tln tlo tlp tlq tlr tls tlt tlu tlv tlx tly tma tmb tmc tmd tme tmf
+tmg tmh tmi tmj tmk tml tmm tmn tmo tmq tmr tms tmt tmu tmv tmw tmy tmz tna tnb tnc tnd tng tnh tni tnk tnl tnm tnn tno
+tnp tnq tnr tns tnt tnu tnv tnw tnx tny tnz tob toc tod tof tog toh toi toj tok tol tom ton too top toq tor tos tou tov
+tow tox toy toz tpa tpc tpe tpf tpg tpi tpj tpk tpl tpm tpn tpo tpp tpq tpr tpt tpu tpv tpw tpx tpy tpz tqb tql tqm tqn
+tqo tqp tqq tqr tqt tqu tqw tra trb trc trd tre trf trg trh tri trj trl trm trn tro trp trq trr trs trt tru trv trw trx
+try trz tsa tsb tsc tsd tse tsg tsh tsi tsj tsk tsl tsm tsn tso tsp tsq tsr tss tst tsu tsv tsw tsx tsy tsz tta ttb ttc
+ttd tte ttf ttg tth tti ttj ttk ttl ttm ttn tto ttp ttq ttr tts ttt ttu ttv ttw tty ttz tua tub tuc tud tue tuf tug tuh
+tui tuj tuk tul tum tun tuo tuq tur tus tuu tuv tux tuy tuz tva tvd tve tvk tvl tvm tvn tvo tvs tvt tvu tvw tvx tvy twa
+twb twc twd twe twf twg twh twi twl twm twn two twp twq twr twt twu tww twx twy txa txb txc txe txg txh txi txj txm txn
+txo txq txr txs txt txu txx txy tya tye tyh tyi tyj tyl tyn typ tyr tys tyt tyu tyv tyx tyy tyz tza tzh tzj tzl tzm tzn
+tzo tzx uam uan uar uba ubi ubl ubr ubu uby uda ude udg udi udj udl udm udu ues ufi uga ugb uge ugh ugn ugo ugy uha uhn
+uig uis uiv uji uka ukg ukh uki ukk ukl ukp ukq ukr uks uku ukv ukw uky ula ulb ulc ule ulf uli ulk ull ulm uln ulu ulw
+uma umb umc umd umg umi umm umn umo ump umr ums umu una und une ung uni unk unm unn unr unu unx unz uon upi upv ura urb
+urc urd ure urf urg urh uri urk url urm urn uro urp urr urt uru urv urw urx ury urz usa ush usi usk usp uss usu uta ute
+uth utp utr utu uum uur uuu uve uvh uvl uwa uya uzb uzn uzs vaa vae vaf vag vah vai vaj val vam van vao vap var vas vau
+vav vay vbb vbk vec ved vel vem ven veo vep ver vgr vgt vic vid vie vif vig vil vin vis vit viv vka vkj vkk vkl vkm vkn
+vko vkp vkt vku vkz vlp vls vma vmb vmc vmd vme vmf vmg vmh vmi vmj vmk vml vmm vmp vmq vmr vms vmu vmv vmw vmx vmy vmz
+vnk vnm vnp vol vor vot vra vro vrs vrt vsi vsl vsv vto vum vun vut vwa waa wab wac wad wae waf wag wah wai waj wal wam
+wan wao wap waq war was wat wau wav waw wax way waz wba wbb wbe wbf wbh wbi wbj wbk wbl wbm wbp wbq wbr wbs wbt wbv wbw
+wca wci wdd wdg wdj wdk wdt wdu wdy wea wec wed weg weh wei wem weo wep wer wes wet weu wew wfg wga wgb wgg wgi wgo wgu
+wgy wha whg whk whu wib wic wie wif wig wih wii wij wik wil wim win wir wiu wiv wiy wja wji wka wkb wkd wkl wkr wku wkw
+wky wla wlc wle wlg wlh wli wlk wll wlm wln wlo wlr wls wlu wlv wlw wlx wly wma wmb wmc wmd wme wmg wmh wmi wmm wmn wmo
+wms wmt wmw wmx wnb wnc wnd wne wng wni wnk wnm wnn wno wnp wnu wnw wny woa wob woc wod woe wof wog woi wok wol wom won
+woo wor wos wow woy wpc wrb wrg wrh wri wrk wrl wrm wrn wro wrp wrr wrs wru wrv wrw wrx wry wrz wsa wsg wsi wsk wsr wss
+wsu wsv wtf wth wti wtk wtm wtw wua wub wud wuh wul wum wun wur wut wuu wuv wux wuy wwa wwb wwo wwr www wxa wxw wyb wyi
+wym wyn wyr wyy xaa xab xac xad xae xag xai xaj xak xal xam xan xao xap xaq xar xas xat xau xav xaw xay xbb xbc xbd xbe
+xbg xbi xbj xbm xbn xbo xbp xbr xbw xby xcb xcc xce xcg xch xcl xcm xcn xco xcr xct xcu xcv xcw xcy xda xdc xdk xdm xdo
+xdq xdy xeb xed xeg xel xem xep xer xes xet xeu xfa xga xgb xgd xgf xgg xgi xgl xgm xgr xgu xgw xha xhc xhd xhe xhm xho
+xhr xht xhu xhv xib xii xil xin xir xis xiv xiy xjb xjt xka xkb xkc xkd xke xkf xkg xki xkj xkk xkl xkn xko xkp xkq xkr
+xks xkt xku xkv xkw xkx xky xkz xla xlb xlc xld xle xlg xli xln xlo xlp xls xlu xly xma xmb xmc xmd xme xmf xmg xmh xmj
+xmk xml xmm xmn xmo xmp xmq xmr xms xmt xmu xmv xmw xmx xmy xmz xna xnb xng xnh xni xnj xnk xnm xnn xno xnq xnr xns xnt
+xnu xny xnz xoc xod xog xoi xok xom xon xoo xop xor xow xpa xpb xpc xpd xpe xpf xpg xph xpi xpj xpk xpl xpm xpn xpo xpp
+xpq xpr xps xpt xpu xpv xpw xpx xpy xpz xqa xqt xra xrb xrd xre xrg xri xrm xrn xrr xrt xru xrw xsa xsb xsc xsd xse xsh
+xsi xsj xsl xsm xsn xso xsp xsq xsr xss xsu xsv xsy xta xtb xtc xtd xte xtg xth xti xtj xtl xtm xtn xto xtp xtq xtr xts
+xtt xtu xtv xtw xty xua xub xud xug xuj xul xum xun xuo xup xur xut xuu xve xvi xvn xvo xvs xwa xwc xwd xwe xwg xwj xwk
+xwl xwo xwr xwt xww xxb xxk xxm xxr xxt xya xyb xyj xyk xyl xyt xyy xzh xzm xzp yaa yab yac yad yae yaf yag yah yai yaj
+yak yal yam yan yao yap yaq yar yas yat yau yav yaw yax yay yaz yba ybb ybe ybh ybi ybj ybk ybl ybm ybn ybo ybx yby ych
+ycl ycn ycp yda ydd yde ydg ydk yea yec yee yei yej yel yer yes yet yeu yev yey yga ygi ygl ygm ygp ygr ygs ygu ygw yha
+yhd yhl yhs yia yid yif yig yih yii yij yik yil yim yin yip yiq yir yis yit yiu yiv yix yiz yka ykg yki ykk ykl ykm ykn
+yko ykr ykt yku yky yla ylb yle ylg yli yll ylm yln ylo ylr ylu yly ymb ymc ymd yme ymg ymh ymi ymk yml ymm ymn ymo ymp
+ymq ymr yms ymx ymz yna ynd yne yng ynk ynl ynn yno ynq yns ynu yob yog yoi yok yol yom yon yor yot yox yoy ypa ypb ypg
+yph ypm ypn ypo ypp ypz yra yrb yre yrk yrl yrm yrn yro yrs yrw yry ysc ysd ysg ysl ysm ysn yso ysp ysr yss ysy yta ytl
+ytp ytw yty yua yub yuc yud yue yuf yug yui yuj yuk yul yum yun yup yuq yur yut yuw yux yuy yuz yva yvt ywa ywg ywl ywn
+ywq ywr ywt ywu yww yxa yxg yxl yxm yxu yxy yyr yyu yyz yzg yzk zaa zab zac zad zae zaf zag zah zai zaj zak zal zam zao
+zap zaq zar zas zat zau zav zaw zax zay zaz zba zbc zbe zbl zbt zbu zbw zca zcd zch zdj zea zeg zeh zen zga zgb zgh zgm
+zgn zgr zha zhb zhd zhi zhn zho zhw zia zib zik zil zim zin ziw ziz zka zkb zkd zkg zkh zkk zkn zko zkp zkr zkt zku zkv
+zkz zla zlj zlm zln zlq zma zmb zmc zmd zme zmf zmg zmh zmi zmj zmk zml zmm zmn zmo zmp zmq zmr zms zmt zmu zmv zmw zmx
+zmy zmz zna zne zng znk zns zoc zoh zom zoo zoq zor zos zpa zpb zpc zpd zpe zpf zpg zph zpi zpj zpk zpl zpm zpn zpo zpp
+zpq zpr zps zpt zpu zpv zpw zpx zpy zpz zqe zra zrg zrn zro zrp zrs zsa zsk zsl zsm zsr zsu zte ztg ztl ztm ztn ztp ztq
+zts ztt ztu ztx zty zua zuh zul zum zun zuy zwa zxx zyb zyg zyj zyn zyp zza zzj
+`
+
+// scriptCodes are the ISO 15924 scripts of the language tags
+var scriptCodes = `
+Adlm Afak Aghb Ahom Arab Aran Armi Armn Avst Bali Bamu Bass Batk Beng Bhks Blis Bopo Brah Brai Bugi Buhd Cakm Cans Cari
+Cham Cher Cirt Copt Cprt Cyrl Cyrs Deva Dsrt Dupl Egyd Egyh Egyp Elba Ethi Geok Geor Glag Goth Gran Grek Gujr Guru Hanb
+Hang Hani Hano Hans Hant Hatr Hebr Hira Hluw Hmng Hrkt Hung Inds Ital Jamo Java Jpan Jurc Kali Kana Khar Khmr Khoj Kitl
+Kits Knda Kore Kpel Kthi Lana Laoo Latf Latg Latn Leke Lepc Limb Lina Linb Lisu Loma Lyci Lydi Mahj Mand Mani Marc Maya
+Mend Merc Mero Mlym Modi Mong Moon Mroo Mtei Mult Mymr Narb Nbat Newa Nkgb Nkoo Nshu Ogam Olck Orkh Orya Osge Osma Palm
+Pauc Perm Phag Phli Phlp Phlv Phnx Piqd Plrd Prti Qaaa Qabx Rjng Roro Runr Samr Sara Sarb Saur Sgnw Shaw Shrd Sidd Sind
+Sinh Sora Sund Sylo Syrc Syre Syrj Syrn Tagb Takr Tale Talu Taml Tang Tavt Telu Teng Tfng Tglg Thaa Thai Tibt Tirh Ugar
+Vaii Visp Wara Wole Xpeo Xsux Yiii Zinh Zmth Zsye Zsym Zxxx Zyyy Zzzz
+`
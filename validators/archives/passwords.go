@@ -0,0 +1,37 @@
+package archives
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strings"
+	"sync"
+)
+
+//go:embed common-passwords.txt
+var commonPasswords string
+
+var commonPasswordIndex map[string]bool
+var commonPasswordOnce sync.Once
+
+// ReadPasswordList reads a password list with one password per line, the empty lines and the lines starting with # are skipped
+func ReadPasswordList(reader io.Reader) (map[string]bool, error) {
+	passwords := make(map[string]bool)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = true
+	}
+	return passwords, scanner.Err()
+}
+
+// IsCommonPassword tells if the password is in the embedded list of the common passwords, the case is ignored
+func IsCommonPassword(password string) bool {
+	commonPasswordOnce.Do(func() {
+		commonPasswordIndex, _ = ReadPasswordList(strings.NewReader(commonPasswords))
+	})
+	return commonPasswordIndex[strings.ToLower(strings.TrimSpace(password))]
+}
@@ -0,0 +1,160 @@
+package archives
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PhoneRule is the calling code and the length of the national significant number of a country
+type PhoneRule struct {
+	CallingCode string
+	MinLength   int
+	MaxLength   int
+	// TrunkPrefix is dropped from the national numbers, "0" in most countries
+	TrunkPrefix string
+}
+
+var nanp = PhoneRule{CallingCode: "1", MinLength: 10, MaxLength: 10, TrunkPrefix: "1"}
+
+// phoneRules are the numbering plans by the alpha-2 code of the country
+var phoneRules = map[string]PhoneRule{
+	"US": nanp, "CA": nanp, "AG": nanp, "AI": nanp, "AS": nanp, "BB": nanp, "BM": nanp, "BS": nanp, "DM": nanp, "DO": nanp,
+	"GD": nanp, "GU": nanp, "JM": nanp, "KN": nanp, "KY": nanp, "LC": nanp, "MP": nanp, "MS": nanp, "PR": nanp, "SX": nanp,
+	"TC": nanp, "TT": nanp, "VC": nanp, "VG": nanp, "VI": nanp,
+	"AE": {"971", 8, 9, "0"},
+	"AR": {"54", 10, 11, "0"},
+	"AT": {"43", 4, 13, "0"},
+	"AU": {"61", 9, 9, "0"},
+	"BD": {"880", 9, 10, "0"},
+	"BE": {"32", 8, 9, "0"},
+	"BG": {"359", 8, 9, "0"},
+	"BH": {"973", 8, 8, ""},
+	"BR": {"55", 10, 11, "0"},
+	"CH": {"41", 9, 9, "0"},
+	"CL": {"56", 9, 9, ""},
+	"CN": {"86", 7, 11, "0"},
+	"CO": {"57", 10, 10, ""},
+	"CZ": {"420", 9, 9, ""},
+	"DE": {"49", 6, 13, "0"},
+	"DK": {"45", 8, 8, ""},
+	"DZ": {"213", 8, 9, "0"},
+	"EE": {"372", 7, 8, ""},
+	"EG": {"20", 9, 10, "0"},
+	"ES": {"34", 9, 9, ""},
+	"FI": {"358", 5, 12, "0"},
+	"FR": {"33", 9, 9, "0"},
+	"GB": {"44", 9, 10, "0"},
+	"GR": {"30", 10, 10, ""},
+	"HK": {"852", 8, 8, ""},
+	"HR": {"385", 8, 9, "0"},
+	"HU": {"36", 8, 9, "06"},
+	"ID": {"62", 8, 12, "0"},
+	"IE": {"353", 7, 9, "0"},
+	"IL": {"972", 8, 9, "0"},
+	"IN": {"91", 10, 10, "0"},
+	"IQ": {"964", 8, 10, "0"},
+	"IR": {"98", 10, 10, "0"},
+	"IS": {"354", 7, 7, ""},
+	"IT": {"39", 6, 11, ""},
+	"JO": {"962", 8, 9, "0"},
+	"JP": {"81", 9, 10, "0"},
+	"KE": {"254", 9, 9, "0"},
+	"KR": {"82", 8, 10, "0"},
+	"KW": {"965", 8, 8, ""},
+	"KZ": {"7", 10, 10, "8"},
+	"LB": {"961", 7, 8, "0"},
+	"LT": {"370", 8, 8, "8"},
+	"LU": {"352", 4, 11, ""},
+	"LV": {"371", 8, 8, ""},
+	"MA": {"212", 9, 9, "0"},
+	"MO": {"853", 8, 8, ""},
+	"MX": {"52", 10, 10, ""},
+	"MY": {"60", 8, 10, "0"},
+	"NG": {"234", 8, 10, "0"},
+	"NL": {"31", 9, 9, "0"},
+	"NO": {"47", 8, 8, ""},
+	"NZ": {"64", 8, 10, "0"},
+	"OM": {"968", 8, 8, ""},
+	"PE": {"51", 8, 9, "0"},
+	"PH": {"63", 8, 10, "0"},
+	"PK": {"92", 9, 10, "0"},
+	"PL": {"48", 9, 9, ""},
+	"PT": {"351", 9, 9, ""},
+	"QA": {"974", 8, 8, ""},
+	"RO": {"40", 9, 9, "0"},
+	"RS": {"381", 8, 9, "0"},
+	"RU": {"7", 10, 10, "8"},
+	"SA": {"966", 8, 9, "0"},
+	"SE": {"46", 7, 10, "0"},
+	"SG": {"65", 8, 8, ""},
+	"SI": {"386", 8, 8, "0"},
+	"SK": {"421", 9, 9, "0"},
+	"SY": {"963", 8, 9, "0"},
+	"TH": {"66", 8, 9, "0"},
+	"TN": {"216", 8, 8, ""},
+	"TR": {"90", 10, 10, "0"},
+	"TW": {"886", 8, 9, "0"},
+	"UA": {"380", 9, 9, "0"},
+	"VN": {"84", 9, 10, "0"},
+	"YE": {"967", 7, 9, "0"},
+	"ZA": {"27", 9, 9, "0"},
+}
+
+// FindPhoneRule finds the numbering plan of the country, the country can be any of its codes or its name
+func FindPhoneRule(country string) (PhoneRule, bool) {
+	c, exists := FindCountry(country)
+	if !exists {
+		return PhoneRule{}, false
+	}
+	rule, exists := phoneRules[c.Alpha2]
+	return rule, exists
+}
+
+// NormalizePhoneNumber removes the spaces, the dashes, the dots and the brackets, "00" at the start is read as "+"
+func NormalizePhoneNumber(number string) string {
+	number = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", " ", "").Replace(strings.TrimSpace(number))
+	if strings.HasPrefix(number, "00") {
+		number = "+" + number[2:]
+	}
+	return number
+}
+
+// ValidatePhoneNumber checks the number with the E.164 rules and returns it as "+<calling code><number>",
+// with a country the calling code and the length of the number are checked as well and with national true
+// the numbers without the calling code like "050 123 4567" are allowed
+func ValidatePhoneNumber(country string, number string, national bool) (string, error) {
+	normalized := NormalizePhoneNumber(number)
+	var c Country
+	var rule PhoneRule
+	var hasRule bool
+	if country != "" {
+		var exists bool
+		if c, exists = FindCountry(country); !exists {
+			return "", fmt.Errorf("%s is not a valid country", country)
+		}
+		rule, hasRule = phoneRules[c.Alpha2]
+	}
+	digits := strings.TrimPrefix(normalized, "+")
+	if digits == "" || !isDigits(digits) {
+		return "", fmt.Errorf("%s is not a valid phone number", number)
+	}
+	if !strings.HasPrefix(normalized, "+") {
+		if !national || !hasRule {
+			return "", fmt.Errorf("%s should start with + and the country calling code", number)
+		}
+		digits = rule.CallingCode + strings.TrimPrefix(digits, rule.TrunkPrefix)
+	}
+	if len(digits) < 7 || len(digits) > 15 || digits[0] == '0' {
+		return "", fmt.Errorf("%s is not a valid phone number", number)
+	}
+	if hasRule {
+		if !strings.HasPrefix(digits, rule.CallingCode) {
+			return "", fmt.Errorf("%s is not a phone number of %s", number, c.Name)
+		}
+		nationalNumber := digits[len(rule.CallingCode):]
+		if len(nationalNumber) < rule.MinLength || len(nationalNumber) > rule.MaxLength {
+			return "", fmt.Errorf("%s should have %d to %d digits after +%s", number, rule.MinLength, rule.MaxLength, rule.CallingCode)
+		}
+	}
+	return "+" + digits, nil
+}
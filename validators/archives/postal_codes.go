@@ -0,0 +1,129 @@
+package archives
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// postalCodePatterns are the postal code formats by the alpha-2 code of the country, an empty pattern is a country without postal codes
+var postalCodePatterns = map[string]string{
+	"AE": "", "AO": "", "BF": "", "BI": "", "BJ": "", "BS": "", "CM": "", "DJ": "", "ER": "", "FJ": "", "GM": "",
+	"HK": "", "KI": "", "KP": "", "ML": "", "MO": "", "QA": "", "SY": "", "TG": "", "TV": "", "UG": "", "YE": "", "ZW": "",
+	"AR": `[A-HJ-NP-Z]?\d{4}(?:[A-Z]{3})?`,
+	"AT": `\d{4}`,
+	"AU": `\d{4}`,
+	"BD": `\d{4}`,
+	"BE": `\d{4}`,
+	"BG": `\d{4}`,
+	"BH": `(?:1|2)?\d{3}`,
+	"BR": `\d{5}-?\d{3}`,
+	"CA": `[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d`,
+	"CH": `\d{4}`,
+	"CL": `\d{7}|\d{3}-\d{4}`,
+	"CN": `\d{6}`,
+	"CO": `\d{6}`,
+	"CZ": `\d{3} ?\d{2}`,
+	"DE": `\d{5}`,
+	"DK": `\d{4}`,
+	"DZ": `\d{5}`,
+	"EE": `\d{5}`,
+	"EG": `\d{5}`,
+	"ES": `\d{5}`,
+	"FI": `\d{5}`,
+	"FR": `\d{2} ?\d{3}`,
+	"GB": `GIR ?0AA|(?:[A-PR-UWYZ][A-HK-Y]?\d[A-Z\d]?) ?\d[ABD-HJLNP-UW-Z]{2}`,
+	"GR": `\d{3} ?\d{2}`,
+	"HR": `\d{5}`,
+	"HU": `\d{4}`,
+	"ID": `\d{5}`,
+	"IE": `(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}`,
+	"IL": `\d{5}(?:\d{2})?`,
+	"IN": `[1-9]\d{2} ?\d{3}`,
+	"IQ": `\d{5}`,
+	"IR": `\d{5}-?\d{5}`,
+	"IS": `\d{3}`,
+	"IT": `\d{5}`,
+	"JO": `\d{5}`,
+	"JP": `\d{3}-?\d{4}`,
+	"KE": `\d{5}`,
+	"KR": `\d{5}`,
+	"KW": `\d{5}`,
+	"LB": `\d{4}(?: ?\d{4})?`,
+	"LT": `(?:LT-)?\d{5}`,
+	"LU": `(?:L-)?\d{4}`,
+	"LV": `LV-\d{4}`,
+	"MA": `\d{5}`,
+	"MX": `\d{5}`,
+	"MY": `\d{5}`,
+	"NG": `\d{6}`,
+	"NL": `\d{4} ?[A-Z]{2}`,
+	"NO": `\d{4}`,
+	"NZ": `\d{4}`,
+	"OM": `\d{3}`,
+	"PE": `\d{5}`,
+	"PH": `\d{4}`,
+	"PK": `\d{5}`,
+	"PL": `\d{2}-\d{3}`,
+	"PT": `\d{4}-\d{3}`,
+	"RO": `\d{6}`,
+	"RS": `\d{5}`,
+	"RU": `\d{6}`,
+	"SA": `\d{5}(?:-?\d{4})?`,
+	"SE": `\d{3} ?\d{2}`,
+	"SG": `\d{6}`,
+	"SI": `\d{4}`,
+	"SK": `\d{3} ?\d{2}`,
+	"TH": `\d{5}`,
+	"TN": `\d{4}`,
+	"TR": `\d{5}`,
+	"TW": `\d{3}(?:\d{2,3})?`,
+	"UA": `\d{5}`,
+	"US": `\d{5}(?:-\d{4})?`,
+	"VN": `\d{6}`,
+	"ZA": `\d{4}`,
+}
+
+var postalCodeRegexes map[string]*regexp.Regexp
+var postalCodeOnce sync.Once
+
+func compilePostalCodes() {
+	postalCodeRegexes = make(map[string]*regexp.Regexp, len(postalCodePatterns))
+	for country, pattern := range postalCodePatterns {
+		if pattern != "" {
+			postalCodeRegexes[country] = regexp.MustCompile(`^(?:` + pattern + `)$`)
+		}
+	}
+}
+
+// HasPostalCodeFormat tells if the format of the postal codes of the country is known
+func HasPostalCodeFormat(country string) bool {
+	c, exists := FindCountry(country)
+	if !exists {
+		return false
+	}
+	_, known := postalCodePatterns[c.Alpha2]
+	return known
+}
+
+// ValidatePostalCode checks the postal code with the format of the country, the country can be any of its codes or its name,
+// the countries without a known format only need a postal code which is not empty
+func ValidatePostalCode(country string, code string) error {
+	c, exists := FindCountry(country)
+	if !exists {
+		return fmt.Errorf("%s is not a valid country", country)
+	}
+	postalCodeOnce.Do(compilePostalCodes)
+	code = strings.ToUpper(strings.TrimSpace(code))
+	pattern, known := postalCodePatterns[c.Alpha2]
+	switch {
+	case known && pattern == "":
+		return fmt.Errorf("%s does not use postal codes", c.Name)
+	case !known && code == "":
+		return fmt.Errorf("postal code can not be empty")
+	case known && !postalCodeRegexes[c.Alpha2].MatchString(code):
+		return fmt.Errorf("%s is not a valid postal code in %s", code, c.Name)
+	}
+	return nil
+}
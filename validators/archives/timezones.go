@@ -0,0 +1,29 @@
+package archives
+
+import (
+	"strings"
+	"sync"
+)
+
+var timezoneIndex map[string]string
+var timezoneIndexOnce sync.Once
+
+func indexTimezones() {
+	names := strings.Fields(timezoneNames)
+	timezoneIndex = make(map[string]string, len(names))
+	for _, name := range names {
+		timezoneIndex[strings.ToLower(name)] = name
+	}
+}
+
+// Timezones returns the names of the IANA timezones, the links like "US/Eastern" are included
+func Timezones() []string {
+	return strings.Fields(timezoneNames)
+}
+
+// FindTimezone finds the IANA timezone ignoring the case and returns its name as it is written in the database
+func FindTimezone(name string) (string, bool) {
+	timezoneIndexOnce.Do(indexTimezones)
+	timezone, exists := timezoneIndex[strings.ToLower(strings.TrimSpace(name))]
+	return timezone, exists
+}
@@ -3,6 +3,7 @@ package validators
 import (
 	"errors"
 	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
 	"reflect"
 )
 
@@ -20,7 +21,7 @@ func ArrayLengthMax(i interface{}, attr map[string]interface{}) error {
 	if !isArray(i) {
 		return errors.New("only arrays are allowed")
 	}
-	if maxLen, ok := attr["max"].(float64); !ok || maxLen < 0 {
+	if maxLen, ok := utils.ToFloat64(attr["max"]); !ok || maxLen < 0 {
 		return errors.New("attribute 'max' must be a non-negative float64")
 	} else if arrLen := reflect.ValueOf(i).Len(); arrLen > int(maxLen) {
 		return fmt.Errorf("array length can not be greater than %d", int(maxLen))
@@ -32,7 +33,7 @@ func ArrayLengthMin(i interface{}, attr map[string]interface{}) error {
 	if !isArray(i) {
 		return errors.New("only arrays are allowed")
 	}
-	if minLen, ok := attr["min"].(float64); !ok || minLen < 0 {
+	if minLen, ok := utils.ToFloat64(attr["min"]); !ok || minLen < 0 {
 		return errors.New("attribute 'min' must be a non-negative float64")
 	} else if arrLen := reflect.ValueOf(i).Len(); arrLen < int(minLen) {
 		return fmt.Errorf("array length can not be lesser than %d", int(minLen))
@@ -74,3 +75,75 @@ func StringsExistsInOptions(i interface{}, attr map[string]interface{}) error {
 	}
 	return nil
 }
+
+func ArrayNotEmpty(i interface{}, _ map[string]interface{}) error {
+	if !isArray(i) {
+		return errors.New("only arrays are allowed")
+	}
+	if reflect.ValueOf(i).Len() == 0 {
+		return errors.New("array can not be empty")
+	}
+	return nil
+}
+
+// UniqueItems checks that no two items of the array are equal, with the attribute 'key'
+// the items are objects and only the value of that key has to be unique
+func UniqueItems(i interface{}, attr map[string]interface{}) error {
+	if !isArray(i) {
+		return errors.New("only arrays are allowed")
+	}
+	key, byKey := attr["key"].(string)
+	items := reflect.ValueOf(i)
+	var seen []interface{}
+	for index := 0; index < items.Len(); index++ {
+		item := items.Index(index).Interface()
+		if byKey {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("item %d is not an object", index)
+			}
+			item = object[key]
+		}
+		for _, previous := range seen {
+			if itemsAreEqual(previous, item) {
+				return fmt.Errorf("item %d is a duplicate", index)
+			}
+		}
+		seen = append(seen, item)
+	}
+	return nil
+}
+
+func itemsAreEqual(a interface{}, b interface{}) bool {
+	if utils.ValuesAreEqual(a, b) {
+		return true
+	}
+	if isArray(a) || isArray(b) || IsObject(a, nil) == nil || IsObject(b, nil) == nil {
+		return reflect.DeepEqual(normalizeItem(a), normalizeItem(b))
+	}
+	return false
+}
+
+// numberItem keeps the exact value of a number so 1 and 1.0 are equal inside objects and arrays
+type numberItem string
+
+func normalizeItem(i interface{}) interface{} {
+	switch v := i.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			normalized[key] = normalizeItem(value)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for index, value := range v {
+			normalized[index] = normalizeItem(value)
+		}
+		return normalized
+	}
+	if number, ok := utils.ToRat(i); ok {
+		return numberItem(number.RatString())
+	}
+	return i
+}
@@ -0,0 +1,143 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"time"
+)
+
+// comparison validators compare the value with the "value" attribute, which can be a literal
+// or a reference to another target key e.g. {"value": {"field": "booking.start"}}
+
+func comparableValue(attr map[string]interface{}) (interface{}, error) {
+	value, ok := attr["value"]
+	if !ok || value == nil {
+		return nil, errors.New("value attribute is required or the referenced field is missing")
+	}
+	return value, nil
+}
+
+func compareWithValue(i interface{}, attr map[string]interface{}) (int, interface{}, error) {
+	value, err := comparableValue(attr)
+	if err != nil {
+		return 0, nil, err
+	}
+	result, err := utils.CompareValues(i, value)
+	if err != nil {
+		return 0, value, err
+	}
+	return result, value, nil
+}
+
+func IsEqualTo(i interface{}, attr map[string]interface{}) error {
+	value, err := comparableValue(attr)
+	if err != nil {
+		return err
+	}
+	if !utils.ValuesAreEqual(i, value) {
+		return fmt.Errorf("%v is not equal to %v", i, value)
+	}
+	return nil
+}
+
+func IsNotEqualTo(i interface{}, attr map[string]interface{}) error {
+	value, err := comparableValue(attr)
+	if err != nil {
+		return err
+	}
+	if utils.ValuesAreEqual(i, value) {
+		return fmt.Errorf("%v should not be equal to %v", i, value)
+	}
+	return nil
+}
+
+func IsGreaterThan(i interface{}, attr map[string]interface{}) error {
+	result, value, err := compareWithValue(i, attr)
+	if err != nil {
+		return err
+	}
+	if result <= 0 {
+		return fmt.Errorf("%v is not greater than %v", i, value)
+	}
+	return nil
+}
+
+func IsGreaterThanOrEqualTo(i interface{}, attr map[string]interface{}) error {
+	result, value, err := compareWithValue(i, attr)
+	if err != nil {
+		return err
+	}
+	if result < 0 {
+		return fmt.Errorf("%v is lesser than %v", i, value)
+	}
+	return nil
+}
+
+func IsLessThan(i interface{}, attr map[string]interface{}) error {
+	result, value, err := compareWithValue(i, attr)
+	if err != nil {
+		return err
+	}
+	if result >= 0 {
+		return fmt.Errorf("%v is not lesser than %v", i, value)
+	}
+	return nil
+}
+
+func IsLessThanOrEqualTo(i interface{}, attr map[string]interface{}) error {
+	result, value, err := compareWithValue(i, attr)
+	if err != nil {
+		return err
+	}
+	if result > 0 {
+		return fmt.Errorf("%v is greater than %v", i, value)
+	}
+	return nil
+}
+
+func datesToCompare(i interface{}, attr map[string]interface{}) (*time.Time, *time.Time, error) {
+	value, err := comparableValue(attr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := i.(string); !ok {
+		return nil, nil, errors.New("invalid date provided")
+	}
+	date := InterfaceToDate(i)
+	if date == nil {
+		return nil, nil, errors.New("invalid date provided")
+	}
+	if _, ok := value.(string); !ok {
+		return nil, nil, fmt.Errorf("%v is not a valid date to compare with", value)
+	}
+	comparableDate := InterfaceToDate(value)
+	if comparableDate == nil {
+		return nil, nil, fmt.Errorf("%v is not a valid date to compare with", value)
+	}
+	return date, comparableDate, nil
+}
+
+func IsDateBefore(i interface{}, attr map[string]interface{}) error {
+	date, comparableDate, err := datesToCompare(i, attr)
+	if err != nil {
+		return err
+	}
+	if !date.Before(*comparableDate) {
+		layout := "2006-01-02 15:04:05"
+		return fmt.Errorf("%s is not before %s", date.Format(layout), comparableDate.Format(layout))
+	}
+	return nil
+}
+
+func IsDateAfter(i interface{}, attr map[string]interface{}) error {
+	date, comparableDate, err := datesToCompare(i, attr)
+	if err != nil {
+		return err
+	}
+	if !date.After(*comparableDate) {
+		layout := "2006-01-02 15:04:05"
+		return fmt.Errorf("%s is not after %s", date.Format(layout), comparableDate.Format(layout))
+	}
+	return nil
+}
@@ -3,112 +3,357 @@ package validators
 import (
 	"errors"
 	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"strings"
 	"time"
 )
 
+const dateErrorLayout = "2006-01-02 15:04:05"
+
+// InterfaceToDate parses the value with any of the known layouts, nil is returned for the values which are not dates
 func InterfaceToDate(i interface{}) *time.Time {
-	dateStr := i.(string)
-	layouts := []string{
-		"2006-01-02",
-		time.Layout,
-		time.ANSIC,
-		time.UnixDate,
-		time.RubyDate,
-		time.RFC822,
-		time.RFC822Z,
-		time.RFC850,
-		time.RFC1123,
-		time.RFC1123Z,
-		time.RFC3339,
-		time.RFC3339Nano,
-		time.Kitchen,
-		time.Stamp,
-		time.StampMilli,
-		time.StampMicro,
-		time.StampNano,
-	}
-
-	for _, layout := range layouts {
-		if validDatetime, err := time.Parse(layout, dateStr); err == nil {
-			return &validDatetime
+	date, err := utils.ParseDate(i, "", time.UTC)
+	if err != nil {
+		return nil
+	}
+	return &date
+}
+
+func timezoneOf(attr map[string]interface{}) (*time.Location, error) {
+	name, _ := attr["timezone"].(string)
+	return utils.LoadTimezone(name)
+}
+
+// dateOf parses the value with the attributes 'layout' and 'timezone', the operators parse the dates the same way
+func dateOf(i interface{}, attr map[string]interface{}) (time.Time, error) {
+	if _, err := timezoneOf(attr); err != nil {
+		return time.Time{}, err
+	}
+	date, err := utils.ParseDateWithAttributes(i, attr)
+	if err != nil {
+		return time.Time{}, errors.New("invalid date provided")
+	}
+	return date, nil
+}
+
+// localDateOf is dateOf in the 'timezone', so the day of a date with an offset is the day in that timezone
+func localDateOf(i interface{}, attr map[string]interface{}) (time.Time, error) {
+	date, err := dateOf(i, attr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	location, _ := timezoneOf(attr)
+	return date.In(location), nil
+}
+
+// dateAttribute reads a date from the attributes, it can be relative to now like "now-18y"
+func dateAttribute(attr map[string]interface{}, name string) (time.Time, error) {
+	value, exists := attr[name]
+	if !exists {
+		return time.Time{}, fmt.Errorf("%s attribute is required", name)
+	}
+	location, err := timezoneOf(attr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	layout, _ := attr["layout"].(string)
+	date, err := utils.ResolveDate(value, layout, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s attribute is not a valid date: %v", name, err)
+	}
+	return date, nil
+}
+
+// PrepareDate checks the timezone and the date attributes when the schema is loaded
+func PrepareDate(names ...string) Preparer {
+	return func(attr map[string]interface{}) error {
+		if _, err := timezoneOf(attr); err != nil {
+			return err
 		}
+		if layout, exists := attr["layout"]; exists {
+			if _, ok := layout.(string); !ok {
+				return errors.New("layout attribute should be a string")
+			}
+		}
+		for _, name := range names {
+			if _, err := dateAttribute(attr, name); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
+}
 
-	return nil
+func IsValidDate(i interface{}, attr map[string]interface{}) error {
+	_, err := dateOf(i, attr)
+	return err
 }
 
-func IsValidDate(i interface{}, _ map[string]interface{}) error {
-	date := InterfaceToDate(i)
-	if date == nil {
-		return errors.New("invalid date provided")
+// IsLessThanNow checks the date has passed
+func IsLessThanNow(i interface{}, attr map[string]interface{}) error {
+	date, err := dateOf(i, attr)
+	if err != nil {
+		return err
+	}
+	if !date.Before(utils.Now()) {
+		return fmt.Errorf("%s has not yet passed", date.Format(dateErrorLayout))
 	}
 	return nil
 }
 
-func IsLessThanNow(i interface{}, _ map[string]interface{}) error {
-	date := InterfaceToDate(i)
-	if date == nil {
-		return errors.New("invalid date provided")
+// IsMoreThanNow checks the date is in the future
+func IsMoreThanNow(i interface{}, attr map[string]interface{}) error {
+	date, err := dateOf(i, attr)
+	if err != nil {
+		return err
 	}
-	now := time.Now()
-	if now.After(*date) {
-		layout := "2006-01-02 15:04:05"
-		return fmt.Errorf("%s has been passed now", date.Format(layout))
+	if !date.After(utils.Now()) {
+		return fmt.Errorf("%s has been passed now", date.Format(dateErrorLayout))
 	}
 	return nil
 }
 
-func IsMoreThanNow(i interface{}, _ map[string]interface{}) error {
-	date := InterfaceToDate(i)
-	if date == nil {
-		return errors.New("invalid date provided")
+// IsBefore checks the date is not after 'maxTime', e.g. "now-18y" for the people who are at least 18 years old
+func IsBefore(i interface{}, attr map[string]interface{}) error {
+	date, err := dateOf(i, attr)
+	if err != nil {
+		return err
+	}
+	comparableDate, err := dateAttribute(attr, "maxTime")
+	if err != nil {
+		return err
 	}
-	now := time.Now()
-	if now.Before(*date) {
-		layout := "2006-01-02 15:04:05"
-		return fmt.Errorf("%s has not yet passed", date.Format(layout))
+	if date.After(comparableDate) {
+		return fmt.Errorf("%s is after %s", date.Format(dateErrorLayout), comparableDate.Format(dateErrorLayout))
 	}
 	return nil
 }
 
-func IsBefore(i interface{}, attr map[string]interface{}) error {
-	date := InterfaceToDate(i)
-	if date == nil {
-		return errors.New("invalid date provided")
+// IsAfter checks the date is not before 'minTime', 'maxTime' is still read for the older schemas
+func IsAfter(i interface{}, attr map[string]interface{}) error {
+	date, err := dateOf(i, attr)
+	if err != nil {
+		return err
+	}
+	name := "minTime"
+	if _, exists := attr[name]; !exists {
+		if _, exists := attr["maxTime"]; exists {
+			name = "maxTime"
+		}
 	}
-	comparableDate := InterfaceToDate(attr["maxTime"])
+	comparableDate, err := dateAttribute(attr, name)
+	if err != nil {
+		return err
+	}
+	if date.Before(comparableDate) {
+		return fmt.Errorf("%s is before %s", date.Format(dateErrorLayout), comparableDate.Format(dateErrorLayout))
+	}
+	return nil
+}
 
-	if date.After(*comparableDate) {
-		layout := "2006-01-02 15:04:05"
-		return fmt.Errorf("%s is after %s", date.Format(layout), comparableDate.Format(layout))
+func PrepareIsAfter(attr map[string]interface{}) error {
+	if _, exists := attr["minTime"]; !exists {
+		if _, exists := attr["maxTime"]; exists {
+			return PrepareDate("maxTime")(attr)
+		}
 	}
+	return PrepareDate("minTime")(attr)
+}
 
+func IsInBetweenTime(i interface{}, attr map[string]interface{}) error {
+	date, err := dateOf(i, attr)
+	if err != nil {
+		return err
+	}
+	comparableMinDate, err := dateAttribute(attr, "minTime")
+	if err != nil {
+		return err
+	}
+	comparableMaxDate, err := dateAttribute(attr, "maxTime")
+	if err != nil {
+		return err
+	}
+	if !(date.Before(comparableMaxDate) && date.After(comparableMinDate)) {
+		return fmt.Errorf("%s is before %s or after %s", date.Format(dateErrorLayout), comparableMinDate.Format(dateErrorLayout), comparableMaxDate.Format(dateErrorLayout))
+	}
 	return nil
 }
-func IsAfter(i interface{}, attr map[string]interface{}) error {
-	date := InterfaceToDate(i)
-	if date == nil {
-		return errors.New("invalid date provided")
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// weekendOf reads the attribute 'weekend', saturday and sunday by default
+func weekendOf(attr map[string]interface{}) (map[time.Weekday]bool, error) {
+	names, exists := attr["weekend"]
+	if !exists {
+		return map[time.Weekday]bool{time.Saturday: true, time.Sunday: true}, nil
+	}
+	return weekdaysOf(names, "weekend")
+}
+
+func weekdaysOf(value interface{}, name string) (map[time.Weekday]bool, error) {
+	names, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s attribute should be a list of days", name)
+	}
+	days := make(map[time.Weekday]bool)
+	for _, n := range names {
+		day, exists := weekdays[strings.ToLower(fmt.Sprint(n))]
+		if !exists {
+			return nil, fmt.Errorf("%v is not a day of the week", n)
+		}
+		days[day] = true
 	}
-	comparableDate := InterfaceToDate(attr["maxTime"])
+	return days, nil
+}
 
-	if date.Before(*comparableDate) {
-		layout := "2006-01-02 15:04:05"
-		return fmt.Errorf("%s is after %s", date.Format(layout), comparableDate.Format(layout))
+func PrepareDays(name string) Preparer {
+	return func(attr map[string]interface{}) error {
+		if _, err := timezoneOf(attr); err != nil {
+			return err
+		}
+		if _, exists := attr[name]; !exists && name != "weekend" {
+			return fmt.Errorf("%s attribute is required", name)
+		}
+		if _, err := weekendOf(attr); err != nil {
+			return err
+		}
+		if name != "weekend" {
+			_, err := weekdaysOf(attr[name], name)
+			return err
+		}
+		_, err := holidaysOf(attr)
+		return err
 	}
+}
 
+// holidaysOf reads the attribute 'holidays', a list of the dates as "2006-01-02"
+func holidaysOf(attr map[string]interface{}) (map[string]bool, error) {
+	holidays := make(map[string]bool)
+	value, exists := attr["holidays"]
+	if !exists {
+		return holidays, nil
+	}
+	dates, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("holidays attribute should be a list of dates as YYYY-MM-DD")
+	}
+	for _, d := range dates {
+		str, ok := d.(string)
+		if !ok {
+			return nil, fmt.Errorf("holiday %v is not a date as YYYY-MM-DD", d)
+		}
+		if _, err := time.Parse("2006-01-02", str); err != nil {
+			return nil, fmt.Errorf("holiday %s is not a date as YYYY-MM-DD", str)
+		}
+		holidays[str] = true
+	}
+	return holidays, nil
+}
+
+// IsDayOfWeek checks the date is on one of the 'days', e.g. ["monday", "wednesday"]
+func IsDayOfWeek(i interface{}, attr map[string]interface{}) error {
+	date, err := localDateOf(i, attr)
+	if err != nil {
+		return err
+	}
+	days, err := weekdaysOf(attr["days"], "days")
+	if err != nil {
+		return err
+	}
+	if !days[date.Weekday()] {
+		return fmt.Errorf("%s is a %s", date.Format("2006-01-02"), date.Weekday())
+	}
 	return nil
 }
-func IsInBetweenTime(i interface{}, attr map[string]interface{}) error {
-	date := InterfaceToDate(i)
-	if date == nil {
-		return errors.New("invalid date provided")
-	}
-	comparableMaxDate := InterfaceToDate(attr["maxTime"])
-	comparableMinDate := InterfaceToDate(attr["minTime"])
-	if !(date.Before(*comparableMaxDate) && date.After(*comparableMinDate)) {
-		layout := "2006-01-02 15:04:05"
-		return fmt.Errorf("%s is before %s or after %s", date.Format(layout), comparableMinDate.Format(layout), comparableMaxDate.Format(layout))
+
+// IsWeekday checks the date in the 'timezone' is not on the 'weekend', which is saturday and sunday by default
+func IsWeekday(i interface{}, attr map[string]interface{}) error {
+	date, err := localDateOf(i, attr)
+	if err != nil {
+		return err
+	}
+	weekend, err := weekendOf(attr)
+	if err != nil {
+		return err
+	}
+	if weekend[date.Weekday()] {
+		return fmt.Errorf("%s is on the weekend", date.Format("2006-01-02"))
+	}
+	return nil
+}
+
+// IsBusinessDay checks the date is a weekday and not one of the 'holidays' given as "2006-01-02"
+func IsBusinessDay(i interface{}, attr map[string]interface{}) error {
+	if err := IsWeekday(i, attr); err != nil {
+		return err
+	}
+	date, _ := localDateOf(i, attr)
+	holidays, err := holidaysOf(attr)
+	if err != nil {
+		return err
+	}
+	if holidays[date.Format("2006-01-02")] {
+		return fmt.Errorf("%s is a holiday", date.Format("2006-01-02"))
+	}
+	return nil
+}
+
+func timeOfDay(value interface{}, name string) (time.Duration, error) {
+	str, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("%s attribute is required as HH:MM or HH:MM:SS", name)
+	}
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, str); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, nil
+		}
+	}
+	return 0, fmt.Errorf("%s attribute is required as HH:MM or HH:MM:SS", name)
+}
+
+func PrepareTimeOfDay(attr map[string]interface{}) error {
+	if _, err := timezoneOf(attr); err != nil {
+		return err
+	}
+	if _, err := timeOfDay(attr["start"], "start"); err != nil {
+		return err
+	}
+	_, err := timeOfDay(attr["end"], "end")
+	return err
+}
+
+// IsTimeOfDayBetween checks the time of the date in the 'timezone' is between 'start' and 'end',
+// when end is before start the range goes over midnight e.g. "22:00" to "06:00"
+func IsTimeOfDayBetween(i interface{}, attr map[string]interface{}) error {
+	date, err := dateOf(i, attr)
+	if err != nil {
+		return err
+	}
+	location, _ := timezoneOf(attr)
+	start, err := timeOfDay(attr["start"], "start")
+	if err != nil {
+		return err
+	}
+	end, err := timeOfDay(attr["end"], "end")
+	if err != nil {
+		return err
+	}
+	local := date.In(location)
+	at := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute + time.Duration(local.Second())*time.Second
+	inRange := at >= start && at <= end
+	if end < start {
+		inRange = at >= start || at <= end
+	}
+	if !inRange {
+		return fmt.Errorf("%s is not between %v and %v", local.Format("15:04:05"), attr["start"], attr["end"])
 	}
 	return nil
 }
@@ -0,0 +1,18 @@
+package validators
+
+import "github.com/DScale-io/jsonschematics/utils"
+
+// ValidationError is an error with parameters, the message has placeholders like {min} which are filled from the params,
+// the engine keeps the params on the error so the custom and localized messages can use the same placeholders
+type ValidationError struct {
+	Message string
+	Params  map[string]interface{}
+}
+
+func NewValidationError(message string, params map[string]interface{}) *ValidationError {
+	return &ValidationError{Message: message, Params: params}
+}
+
+func (e *ValidationError) Error() string {
+	return utils.FormatParams(e.Message, e.Params)
+}
@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/expressions"
+)
+
+// CompiledExpressionKey is the attribute in which PrepareExpr keeps the compiled expression
+const CompiledExpressionKey = "COMPILED_EXPRESSION"
+
+func PrepareExpr(attr map[string]interface{}) error {
+	source, ok := attr["expression"].(string)
+	if !ok {
+		return errors.New("expression is required in the validator's attributes")
+	}
+	program, err := expressions.Compile(source)
+	if err != nil {
+		return err
+	}
+	attr[CompiledExpressionKey] = program
+	return nil
+}
+
+func Expr(i interface{}, attr map[string]interface{}) error {
+	program, ok := attr[CompiledExpressionKey].(*expressions.Program)
+	if !ok {
+		if err := PrepareExpr(attr); err != nil {
+			return err
+		}
+		program = attr[CompiledExpressionKey].(*expressions.Program)
+	}
+	db, _ := attr["DB"].(map[string]interface{})
+	document, _ := attr["DOCUMENT"].(map[string]interface{})
+	holds, err := program.EvalBool(expressions.Env{
+		Value:    i,
+		Document: document,
+		DB:       db,
+	})
+	if err != nil {
+		return err
+	}
+	if !holds {
+		return fmt.Errorf("%v does not satisfy %s", i, program)
+	}
+	return nil
+}
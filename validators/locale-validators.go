@@ -1,38 +1,240 @@
 package validators
 
 import (
-	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/DScale-io/jsonschematics/validators/archives"
-	"os"
 	"strings"
 )
 
-func getCountriesList() *map[string]string {
-	var list map[string]string
-	content, err := os.ReadFile("archives/countriesList.json")
+// codeFormat reads the attribute 'format' and checks it is one of the formats
+func codeFormat(attr map[string]interface{}, formats ...string) (string, error) {
+	format, exists := attr["format"]
+	if !exists {
+		return "", nil
+	}
+	name, _ := format.(string)
+	for _, f := range formats {
+		if strings.EqualFold(name, f) {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("format attribute should be one of %s", strings.Join(formats, ", "))
+}
+
+func PrepareCodeFormat(formats ...string) Preparer {
+	return func(attr map[string]interface{}) error {
+		_, err := codeFormat(attr, formats...)
+		return err
+	}
+}
+
+// IsCountryValid checks the ISO 3166-1 country, the attribute 'format' can be alpha2, alpha3, numeric or name,
+// any of them is allowed by default and the case is ignored
+func IsCountryValid(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	format, err := codeFormat(attr, "alpha2", "alpha3", "numeric", "name")
 	if err != nil {
-		return nil
+		return err
+	}
+	value := strings.TrimSpace(i.(string))
+	country, exists := archives.FindCountry(value)
+	if exists {
+		switch format {
+		case "alpha2":
+			exists = strings.EqualFold(country.Alpha2, value)
+		case "alpha3":
+			exists = strings.EqualFold(country.Alpha3, value)
+		case "numeric":
+			exists = country.Numeric == value
+		case "name":
+			exists = strings.EqualFold(country.Name, value)
+		}
 	}
-	err = json.Unmarshal(content, &list)
+	if !exists {
+		return errors.New("this is an invalid country")
+	}
+	return nil
+}
+
+// IsCurrencyCode checks the ISO 4217 currency, the attribute 'format' can be alpha or numeric
+func IsCurrencyCode(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	format, err := codeFormat(attr, "alpha", "numeric")
 	if err != nil {
-		return nil
+		return err
 	}
-	return &list
+	value := strings.TrimSpace(i.(string))
+	currency, exists := archives.FindCurrency(value)
+	if exists {
+		switch format {
+		case "alpha":
+			exists = strings.EqualFold(currency.Code, value)
+		case "numeric":
+			exists = currency.Numeric == value
+		}
+	}
+	if !exists {
+		return NewValidationError("{value} is not a valid currency", map[string]interface{}{"value": value})
+	}
+	return nil
+}
+
+// IsCurrencyAmount checks the amount does not have more decimal places than the minor units of the 'currency',
+// the currency can reference another field e.g. {"field": "price.currency"}
+func IsCurrencyAmount(i interface{}, attr map[string]interface{}) error {
+	code, ok := attr["currency"].(string)
+	if !ok {
+		return errors.New("currency attribute is required")
+	}
+	currency, exists := archives.FindCurrency(code)
+	if !exists {
+		return NewValidationError("{currency} is not a valid currency", map[string]interface{}{"currency": code})
+	}
+	places, err := decimalPlaces(i)
+	if err != nil {
+		return err
+	}
+	if currency.MinorUnits >= 0 && places > currency.MinorUnits {
+		return NewValidationError("{value} can not have more than {minor_units} decimal places in {currency}", map[string]interface{}{
+			"value":       i,
+			"minor_units": currency.MinorUnits,
+			"currency":    currency.Code,
+		})
+	}
+	return nil
+}
+
+func PrepareCurrencyAmount(attr map[string]interface{}) error {
+	switch currency := attr["currency"].(type) {
+	case string:
+		if _, exists := archives.FindCurrency(currency); !exists {
+			return fmt.Errorf("%s is not a valid currency", currency)
+		}
+	case map[string]interface{}:
+		// reference to another field
+	default:
+		return errors.New("currency attribute is required")
+	}
+	return nil
 }
 
-func IsCountryValid(i interface{}, _ map[string]interface{}) error {
-	userCountry := i.(string)
-	countries := archives.GetCountries()
-	if countries != nil {
-		for code, country := range *countries {
-			uc := strings.ToLower(userCountry)
-			c := strings.ToLower(country)
-			cd := strings.ToLower(code)
-			if uc != c && uc != cd {
-				return errors.New("this is an invalid country")
-			}
+// IsLanguageCode checks the ISO 639 language code, the attribute 'format' can be alpha2 or alpha3
+func IsLanguageCode(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	format, err := codeFormat(attr, "alpha2", "alpha3")
+	if err != nil {
+		return err
+	}
+	value := strings.TrimSpace(i.(string))
+	language, exists := archives.FindLanguage(value)
+	if exists {
+		switch format {
+		case "alpha2":
+			exists = strings.EqualFold(language.Alpha2, value)
+		case "alpha3":
+			exists = strings.EqualFold(language.Alpha3, value)
+		default:
+			exists = strings.EqualFold(language.Alpha2, value) || strings.EqualFold(language.Alpha3, value)
 		}
 	}
+	if !exists {
+		return NewValidationError("{value} is not a valid language", map[string]interface{}{"value": value})
+	}
+	return nil
+}
+
+// IsLanguageTag checks the BCP 47 language tag like "en-US" or "ar-Arab-SA"
+func IsLanguageTag(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	return archives.ValidateLanguageTag(i.(string))
+}
+
+// IsTimezone checks the IANA timezone like "Asia/Dubai", the case is ignored
+func IsTimezone(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	if _, exists := archives.FindTimezone(i.(string)); !exists {
+		return NewValidationError("{value} is not a valid timezone", map[string]interface{}{"value": i})
+	}
 	return nil
 }
+
+// countryAttribute reads the attribute 'country', it can reference another field e.g. {"field": "address.country"}
+func countryAttribute(attr map[string]interface{}, required bool) (string, error) {
+	country, exists := attr["country"]
+	if !exists || country == nil {
+		if required {
+			return "", errors.New("country attribute is required")
+		}
+		return "", nil
+	}
+	name, ok := country.(string)
+	if !ok {
+		return "", fmt.Errorf("%v is not a valid country", country)
+	}
+	return name, nil
+}
+
+func PrepareCountry(required bool) Preparer {
+	return func(attr map[string]interface{}) error {
+		if _, isReference := attr["country"].(map[string]interface{}); isReference {
+			return nil
+		}
+		country, err := countryAttribute(attr, required)
+		if err != nil {
+			return err
+		}
+		if _, exists := archives.FindCountry(country); country != "" && !exists {
+			return fmt.Errorf("%s is not a valid country", country)
+		}
+		return nil
+	}
+}
+
+// IsPostalCode checks the postal code with the format of the 'country', with 'strict' true the countries
+// without a known format are rejected
+func IsPostalCode(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	country, err := countryAttribute(attr, true)
+	if err != nil {
+		return err
+	}
+	if strict, _ := attr["strict"].(bool); strict && !archives.HasPostalCodeFormat(country) {
+		return NewValidationError("postal codes of {country} can not be validated", map[string]interface{}{"country": country})
+	}
+	return archives.ValidatePostalCode(country, i.(string))
+}
+
+// IsPhoneNumber checks the E.164 phone number like "+971501234567", with the 'country' the calling code and the
+// length are checked and with 'national' true the numbers without the calling code are allowed
+func IsPhoneNumber(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	country, err := countryAttribute(attr, false)
+	if err != nil {
+		return err
+	}
+	national, _ := attr["national"].(bool)
+	_, err = archives.ValidatePhoneNumber(country, i.(string), national)
+	return err
+}
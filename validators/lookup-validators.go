@@ -0,0 +1,90 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+)
+
+const (
+	// LookupProviderKey is the attribute in which PrepareLookup keeps the provider of a file
+	LookupProviderKey = "LOOKUP_PROVIDER"
+	// LookupCacheKey is the attribute with the cache of the current validation run
+	LookupCacheKey = "LOOKUP_CACHE"
+)
+
+// PrepareLookup loads the file of the lookup once, a relative path is opened from the working directory.
+// The named providers are resolved when validating as they can be registered after the schema is loaded
+func PrepareLookup(attr map[string]interface{}) error {
+	if _, ok := attr["lookup"].(string); ok {
+		return nil
+	}
+	path, ok := attr["file"].(string)
+	if !ok {
+		return errors.New("attribute 'lookup' or 'file' is required")
+	}
+	column, _ := attr["column"].(string)
+	provider, err := NewFileLookup(path, column)
+	if err != nil {
+		return err
+	}
+	attr[LookupProviderKey] = provider
+	return nil
+}
+
+func (v *Validators) RegisterLookupProvider(name string, provider LookupProvider) {
+	v.Logger.DEBUG("registering lookup provider:", name)
+	if v.Lookups == nil {
+		v.Lookups = make(map[string]LookupProvider)
+	}
+	v.Lookups[name] = provider
+}
+
+func (v *Validators) lookup(i interface{}, attr map[string]interface{}) (bool, error) {
+	value, isString := i.(string)
+	if number, ok := utils.ToRat(i); ok {
+		value = utils.FormatRat(number)
+	} else if !isString {
+		return false, errors.New("only strings and numbers can be looked up")
+	}
+	var name string
+	var provider LookupProvider
+	if lookupName, ok := attr["lookup"].(string); ok {
+		name = "lookup:" + lookupName
+		if provider = v.Lookups[lookupName]; provider == nil {
+			return false, fmt.Errorf("lookup provider %s is not registered", lookupName)
+		}
+	} else {
+		if _, prepared := attr[LookupProviderKey].(LookupProvider); !prepared {
+			if err := PrepareLookup(attr); err != nil {
+				return false, err
+			}
+		}
+		name = fmt.Sprintf("file:%v:%v", attr["file"], attr["column"])
+		provider = attr[LookupProviderKey].(LookupProvider)
+	}
+	cache, _ := attr[LookupCacheKey].(*LookupCache)
+	return cache.contains(name, provider, value)
+}
+
+func (v *Validators) InLookup(i interface{}, attr map[string]interface{}) error {
+	found, err := v.lookup(i, attr)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%v is not in the lookup", i)
+	}
+	return nil
+}
+
+func (v *Validators) NotInLookup(i interface{}, attr map[string]interface{}) error {
+	found, err := v.lookup(i, attr)
+	if err != nil {
+		return err
+	}
+	if found {
+		return fmt.Errorf("%v is in the lookup", i)
+	}
+	return nil
+}
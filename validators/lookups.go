@@ -0,0 +1,181 @@
+package validators
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// LookupProvider tells if a value exists in a list of options which lives outside the schema
+type LookupProvider interface {
+	Contains(value string) (bool, error)
+}
+
+// MapLookup keeps the options in memory
+type MapLookup struct {
+	options map[string]bool
+}
+
+func NewMapLookup(options ...interface{}) *MapLookup {
+	lookup := &MapLookup{options: make(map[string]bool, len(options))}
+	for _, option := range options {
+		lookup.options[lookupKey(option)] = true
+	}
+	return lookup
+}
+
+// lookupKey writes the option as it is looked up, numbers are written in their shortest exact form so 42, 42.0
+// and json.Number("42") are the same option
+func lookupKey(option interface{}) string {
+	if number, ok := utils.ToRat(option); ok {
+		return utils.FormatRat(number)
+	}
+	return fmt.Sprint(option)
+}
+
+func (m *MapLookup) Contains(value string) (bool, error) {
+	return m.options[value], nil
+}
+
+// NewJSONFileLookup loads the options from a json array, for an array of objects key is the property holding the option
+func NewJSONFileLookup(path string, key string) (*MapLookup, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var items []interface{}
+	if err := utils.DecodeJSON(content, &items); err != nil {
+		return nil, fmt.Errorf("%s is not a json array: %v", path, err)
+	}
+	lookup := NewMapLookup()
+	for _, item := range items {
+		if key != "" {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s should only have objects", path)
+			}
+			item = object[key]
+		}
+		if item != nil {
+			lookup.options[lookupKey(item)] = true
+		}
+	}
+	return lookup, nil
+}
+
+// NewCSVFileLookup loads the options from the column of a csv file, the first row is the header
+func NewCSVFileLookup(path string, column string) (*MapLookup, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s is empty", path)
+	}
+	index := -1
+	for i, name := range records[0] {
+		if strings.TrimSpace(name) == column {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("%s has no column %s", path, column)
+	}
+	lookup := NewMapLookup()
+	for _, record := range records[1:] {
+		if index < len(record) {
+			lookup.addCSVOption(strings.TrimSpace(record[index]))
+		}
+	}
+	return lookup, nil
+}
+
+// addCSVOption keeps the cell as it is for the strings, a cell which is a number is also kept with lookupKey
+// so "42.0" in the file is found for 42 in the data
+func (m *MapLookup) addCSVOption(cell string) {
+	m.options[cell] = true
+	var number interface{}
+	if err := utils.DecodeJSON([]byte(cell), &number); err == nil {
+		if _, ok := number.(json.Number); ok {
+			m.options[lookupKey(number)] = true
+		}
+	}
+}
+
+// NewFileLookup picks the json or csv provider by the extension of the file
+func NewFileLookup(path string, column string) (*MapLookup, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return NewJSONFileLookup(path, column)
+	case ".csv":
+		return NewCSVFileLookup(path, column)
+	}
+	return nil, fmt.Errorf("only json and csv files can be used for lookups, got %s", path)
+}
+
+// SQLLookup runs the query with the value as its only argument, the value exists when any row is returned
+type SQLLookup struct {
+	DB    *sql.DB
+	Query string
+}
+
+func NewSQLLookup(db *sql.DB, query string) *SQLLookup {
+	return &SQLLookup{DB: db, Query: query}
+}
+
+func (s *SQLLookup) Contains(value string) (bool, error) {
+	if s.DB == nil {
+		return false, errors.New("no database for the lookup")
+	}
+	rows, err := s.DB.Query(s.Query, value)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	exists := rows.Next()
+	return exists, rows.Err()
+}
+
+// LookupCache keeps the results of the lookups for one validation run,
+// so the same value is only looked up once for all the rows
+type LookupCache struct {
+	mu      sync.Mutex
+	results map[string]map[string]bool
+}
+
+func NewLookupCache() *LookupCache {
+	return &LookupCache{results: make(map[string]map[string]bool)}
+}
+
+func (c *LookupCache) contains(name string, provider LookupProvider, value string) (bool, error) {
+	if c == nil {
+		return provider.Contains(value)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if found, exists := c.results[name][value]; exists {
+		return found, nil
+	}
+	found, err := provider.Contains(value)
+	if err != nil {
+		return false, err
+	}
+	if c.results[name] == nil {
+		c.results[name] = make(map[string]bool)
+	}
+	c.results[name][value] = found
+	return found, nil
+}
@@ -0,0 +1,297 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const defaultNetworkTimeout = 5 * time.Second
+
+// sharedAddressSpace is the carrier grade NAT range of RFC 6598, it is not public either
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// NetworkChecker makes the requests of the network validators, by default it only calls public addresses
+type NetworkChecker struct {
+	// Client is used for the requests, its transport is wrapped so the addresses are checked when dialing
+	Client *http.Client
+	// AllowHosts limits the requests to these hosts, "*.example.com" allows the subdomains
+	AllowHosts []string
+	// DenyHosts are never called, they win over AllowHosts
+	DenyHosts []string
+	// AllowPrivate allows loopback, private, shared and link local addresses
+	AllowPrivate bool
+	// Offline skips the requests, only the url and the hosts are checked
+	Offline bool
+	// CacheTTL keeps the status codes of the urls, 0 disables the cache
+	CacheTTL time.Duration
+	// MaxConcurrent limits the requests running at the same time, 0 is unlimited
+	MaxConcurrent int
+	// Timeout of a request when the validator has no timeout attribute
+	Timeout time.Duration
+
+	once      sync.Once
+	client    *http.Client
+	clientErr error
+	requests  chan struct{}
+	mu        sync.Mutex
+	cache     map[string]cachedStatus
+}
+
+type cachedStatus struct {
+	statusCode int
+	expires    time.Time
+}
+
+// DefaultNetworkChecker is used by StatusCodeCheck
+var DefaultNetworkChecker = &NetworkChecker{}
+
+func (n *NetworkChecker) init() {
+	n.once.Do(func() {
+		n.cache = make(map[string]cachedStatus)
+		if n.MaxConcurrent > 0 {
+			n.requests = make(chan struct{}, n.MaxConcurrent)
+		}
+		n.client, n.clientErr = n.safeClient()
+	})
+}
+
+// safeClient copies the client of the user, its transport checks the address every time it connects so a public name
+// pointing to a private address is refused and the redirects are checked like the url before its own CheckRedirect
+func (n *NetworkChecker) safeClient() (*http.Client, error) {
+	client := &http.Client{}
+	if n.Client != nil {
+		copied := *n.Client
+		client = &copied
+	}
+	if !n.AllowPrivate {
+		if err := n.checkTransport(client); err != nil {
+			return nil, err
+		}
+	}
+	checkRedirect := client.CheckRedirect
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if err := n.checkURL(req.URL); err != nil {
+			return err
+		}
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+		if len(via) >= 10 {
+			return errors.New("too many redirects")
+		}
+		return nil
+	}
+	return client, nil
+}
+
+// checkTransport wraps the transport of the client so the address is checked before connecting
+func (n *NetworkChecker) checkTransport(client *http.Client) error {
+	var transport *http.Transport
+	switch t := client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return fmt.Errorf("the addresses can not be checked with the transport %T, it has to be an *http.Transport", t)
+	}
+	// a proxy would connect to the host instead, so the address could not be checked
+	transport.Proxy = nil
+	if transport.DialContext != nil {
+		transport.DialContext = n.checkedDial(transport.DialContext)
+	} else {
+		dialer := &net.Dialer{
+			Timeout: n.timeout(),
+			Control: func(_ string, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				return n.checkIP(net.ParseIP(host))
+			},
+		}
+		transport.DialContext = dialer.DialContext
+	}
+	if transport.DialTLSContext != nil {
+		transport.DialTLSContext = n.checkedDial(transport.DialTLSContext)
+	}
+	client.Transport = transport
+	return nil
+}
+
+// checkedDial checks the remote address of the connections made by a dial function of the user
+func (n *NetworkChecker) checkedDial(dial func(ctx context.Context, network, address string) (net.Conn, error)) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		conn, err := dial(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+		host, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+		if err == nil {
+			err = n.checkIP(net.ParseIP(host))
+		}
+		if err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		return conn, nil
+	}
+}
+
+func (n *NetworkChecker) timeout() time.Duration {
+	if n.Timeout > 0 {
+		return n.Timeout
+	}
+	return defaultNetworkTimeout
+}
+
+func hostMatches(host string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if strings.HasPrefix(pattern, "*.") {
+			if strings.HasSuffix(host, pattern[1:]) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *NetworkChecker) checkIP(ip net.IP) error {
+	if ip == nil {
+		return errors.New("invalid address")
+	}
+	if n.AllowPrivate {
+		return nil
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("address %s is not allowed", ip)
+	}
+	return nil
+}
+
+func (n *NetworkChecker) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme %s is not allowed", u.Scheme)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return errors.New("url has no host")
+	}
+	if hostMatches(host, n.DenyHosts) {
+		return fmt.Errorf("host %s is not allowed", host)
+	}
+	if len(n.AllowHosts) > 0 && !hostMatches(host, n.AllowHosts) {
+		return fmt.Errorf("host %s is not allowed", host)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return n.checkIP(ip)
+	}
+	return nil
+}
+
+func (n *NetworkChecker) cached(rawURL string) (int, bool) {
+	if n.CacheTTL <= 0 {
+		return 0, false
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	status, exists := n.cache[rawURL]
+	if !exists || time.Now().After(status.expires) {
+		return 0, false
+	}
+	return status.statusCode, true
+}
+
+func (n *NetworkChecker) remember(rawURL string, statusCode int) {
+	if n.CacheTTL <= 0 {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.cache[rawURL] = cachedStatus{statusCode: statusCode, expires: time.Now().Add(n.CacheTTL)}
+}
+
+// Status sends a HEAD request to the url and returns the status code, the url has to pass the checks of the hosts first
+func (n *NetworkChecker) Status(rawURL string, timeout time.Duration) (int, error) {
+	n.init()
+	if n.clientErr != nil {
+		return 0, n.clientErr
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, err
+	}
+	if err := n.checkURL(u); err != nil {
+		return 0, err
+	}
+	if statusCode, exists := n.cached(rawURL); exists {
+		return statusCode, nil
+	}
+	if timeout <= 0 {
+		timeout = n.timeout()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if n.requests != nil {
+		select {
+		case n.requests <- struct{}{}:
+			defer func() { <-n.requests }()
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	n.remember(rawURL, resp.StatusCode)
+	return resp.StatusCode, nil
+}
+
+// StatusCodeCheck checks the status code of the url, the attribute 'timeout' is in seconds
+func (n *NetworkChecker) StatusCodeCheck(i interface{}, attr map[string]interface{}) error {
+	if err := IsString(i, attr); err != nil {
+		return err
+	}
+	u, err := url.Parse(i.(string))
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%v is not a valid url", i)
+	}
+	expectedStatusCode := http.StatusOK
+	if sc, ok := utils.ToFloat64(attr["status_code"]); ok {
+		expectedStatusCode = int(sc)
+	}
+	var timeout time.Duration
+	if to, ok := utils.ToFloat64(attr["timeout"]); ok {
+		timeout = time.Duration(to * float64(time.Second))
+	}
+	if n.Offline {
+		return n.checkURL(u)
+	}
+	statusCode, err := n.Status(i.(string), timeout)
+	if err != nil {
+		return fmt.Errorf("failed to perform HEAD request: %v", err)
+	}
+	if statusCode != expectedStatusCode {
+		return fmt.Errorf("url is not throwing status code: %d, it is sending %d", expectedStatusCode, statusCode)
+	}
+	return nil
+}
@@ -1,9 +1,13 @@
 package validators
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
-	"reflect"
+	"github.com/DScale-io/jsonschematics/utils"
+	"math"
+	"math/big"
+	"strings"
 )
 
 var NumberTypes = map[string][]string{
@@ -20,78 +24,37 @@ var NumberTypes = map[string][]string{
 }
 
 func convertToFloat64(i interface{}) *float64 {
-	var result float64
-	switch v := i.(type) {
-	case int:
-		result = float64(v)
-	case int8:
-		result = float64(v)
-	case int16:
-		result = float64(v)
-	case int32:
-		result = float64(v)
-	case int64:
-		result = float64(v)
-	case uint:
-		result = float64(v)
-	case uint8:
-		result = float64(v)
-	case uint16:
-		result = float64(v)
-	case uint32:
-		result = float64(v)
-	case uint64:
-		result = float64(v)
-	case float32:
-		result = float64(v)
-	case float64:
-		result = v
-	default:
+	result, ok := utils.ToFloat64(i)
+	if !ok {
 		return nil
 	}
 	return &result
 }
 
+// IsInteger accepts the numbers without a fraction, also json numbers like 42 or 42.0
 func IsInteger(i interface{}, _ map[string]interface{}) error {
-	typeOfInterface := reflect.TypeOf(i).String()
-	switch typeOfInterface {
-	case "int":
-		return nil
-	case "int8":
-		return nil
-	case "int16":
-		return nil
-	case "int32":
-		return nil
-	case "int64":
-		return nil
-	case "uint":
-		return nil
-	case "uint8":
-		return nil
-	case "uint16":
-		return nil
-	case "uint32":
-		return nil
-	case "uint64":
+	switch i.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
 		return nil
-	default:
-		return errors.New("value is not an integer")
-	}	
-	return nil
+	case json.Number, float32, float64:
+		if utils.IsWholeNumber(i) {
+			return nil
+		}
+	}
+	return errors.New("value is not an integer")
 }
 
+// IsFloat accepts the go floats and the json numbers written with a fraction or an exponent
 func IsFloat(i interface{}, _ map[string]interface{}) error {
-	typeOfInterface := reflect.TypeOf(i).String()
-	switch typeOfInterface {
-	case "float32":
-		return nil
-	case "float64":
+	switch v := i.(type) {
+	case float32, float64:
 		return nil
-	default:
-		return errors.New("value is not an integer")
-	}		
-	return nil
+	case json.Number:
+		if _, ok := utils.ToRat(v); ok && strings.ContainsAny(string(v), ".eE") {
+			return nil
+		}
+	}
+	return errors.New("value is not a floating number")
 }
 
 func IsNumber(i interface{}, attr map[string]interface{}) error {
@@ -104,38 +67,59 @@ func IsNumber(i interface{}, attr map[string]interface{}) error {
 	return errors.New("value is neither integer not floating number")
 }
 
-func MaxAllowed(i interface{}, attributes map[string]interface{}) error {
-	number := convertToFloat64(i)
-	if number == nil {
-		return errors.New(fmt.Sprintf("%v is not a number", i))
+func ratAttribute(attributes map[string]interface{}, name string) (*big.Rat, error) {
+	if _, exists := attributes[name]; !exists {
+		return nil, fmt.Errorf("%s attribute is required", name)
+	}
+	number, ok := utils.ToRat(attributes[name])
+	if !ok {
+		return nil, fmt.Errorf("%s attribute should be a number", name)
+	}
+	return number, nil
+}
+
+func intAttribute(attributes map[string]interface{}, name string) (int, error) {
+	number, err := ratAttribute(attributes, name)
+	if err != nil {
+		return 0, err
 	}
-	if _, ok := attributes["max"]; !ok {
-		return errors.New("max attribute is required")
+	if !number.IsInt() || number.Sign() < 0 || !number.Num().IsInt64() {
+		return 0, fmt.Errorf("%s attribute should be a non-negative integer", name)
 	}
-	_max := convertToFloat64(attributes["max"])
-	if _max == nil {
-		return errors.New("max attribute should be a number")
+	return int(number.Num().Int64()), nil
+}
+
+// compareWithAttribute compares the value with the number in the attribute exactly
+func compareWithAttribute(i interface{}, attributes map[string]interface{}, name string) (int, error) {
+	number, ok := utils.ToRat(i)
+	if !ok {
+		return 0, fmt.Errorf("%v is not a number", i)
 	}
-	if *number > *_max {
-		return errors.New(fmt.Sprintf("%d is greater than %d", *number, *_max))
+	limit, err := ratAttribute(attributes, name)
+	if err != nil {
+		return 0, err
 	}
-	return nil
+	return number.Cmp(limit), nil
 }
 
-func MinAllowed(i interface{}, attributes map[string]interface{}) error {
-	number := convertToFloat64(i)
-	if number == nil {
-		return errors.New(fmt.Sprintf("%v is not a number", i))
+func MaxAllowed(i interface{}, attributes map[string]interface{}) error {
+	result, err := compareWithAttribute(i, attributes, "max")
+	if err != nil {
+		return err
 	}
-	if _, ok := attributes["min"]; !ok {
-		return errors.New("min attribute is required")
+	if result > 0 {
+		return fmt.Errorf("%v is greater than %v", i, attributes["max"])
 	}
-	_max := convertToFloat64(attributes["min"])
-	if _max == nil {
-		return errors.New("min attribute should be a number")
+	return nil
+}
+
+func MinAllowed(i interface{}, attributes map[string]interface{}) error {
+	result, err := compareWithAttribute(i, attributes, "min")
+	if err != nil {
+		return err
 	}
-	if *number < *_max {
-		return errors.New(fmt.Sprintf("%d is lesser than %d", *number, *_max))
+	if result < 0 {
+		return fmt.Errorf("%v is lesser than %v", i, attributes["min"])
 	}
 	return nil
 }
@@ -157,7 +141,281 @@ func IsGreaterThanZero(i interface{}, _ map[string]interface{}) error {
 }
 
 func IsLesserThanZero(i interface{}, _ map[string]interface{}) error {
-	return MinAllowed(i, map[string]interface{}{
+	return MaxAllowed(i, map[string]interface{}{
 		"max": 0,
 	})
 }
+
+// PrepareRange checks the attributes of the range validators, min has to be lesser than max when both are given
+func PrepareRange(names ...string) Preparer {
+	return func(attr map[string]interface{}) error {
+		for _, name := range names {
+			if _, err := ratAttribute(attr, name); err != nil {
+				return err
+			}
+		}
+		if len(names) == 2 {
+			lower, _ := ratAttribute(attr, names[0])
+			upper, _ := ratAttribute(attr, names[1])
+			if lower.Cmp(upper) > 0 {
+				return fmt.Errorf("%s attribute can not be greater than %s attribute", names[0], names[1])
+			}
+		}
+		return nil
+	}
+}
+
+func ExclusiveMinAllowed(i interface{}, attributes map[string]interface{}) error {
+	result, err := compareWithAttribute(i, attributes, "min")
+	if err != nil {
+		return err
+	}
+	if result <= 0 {
+		return NewValidationError("{value} should be greater than {min}", map[string]interface{}{"value": i, "min": attributes["min"]})
+	}
+	return nil
+}
+
+func ExclusiveMaxAllowed(i interface{}, attributes map[string]interface{}) error {
+	result, err := compareWithAttribute(i, attributes, "max")
+	if err != nil {
+		return err
+	}
+	if result >= 0 {
+		return NewValidationError("{value} should be lesser than {max}", map[string]interface{}{"value": i, "max": attributes["max"]})
+	}
+	return nil
+}
+
+func ExclusiveInBetween(i interface{}, attributes map[string]interface{}) error {
+	if err := ExclusiveMinAllowed(i, attributes); err != nil {
+		return err
+	}
+	return ExclusiveMaxAllowed(i, attributes)
+}
+
+func PrepareMultipleOf(attr map[string]interface{}) error {
+	number, err := ratAttribute(attr, "value")
+	if err != nil {
+		return err
+	}
+	if number.Sign() <= 0 {
+		return errors.New("value attribute should be greater than 0")
+	}
+	return nil
+}
+
+func MultipleOf(i interface{}, attributes map[string]interface{}) error {
+	number, ok := utils.ToRat(i)
+	if !ok {
+		return fmt.Errorf("%v is not a number", i)
+	}
+	of, err := ratAttribute(attributes, "value")
+	if err != nil {
+		return err
+	}
+	if of.Sign() == 0 || !new(big.Rat).Quo(number, of).IsInt() {
+		return NewValidationError("{value} is not a multiple of {of}", map[string]interface{}{"value": i, "of": attributes["value"]})
+	}
+	return nil
+}
+
+// decimalPlaces returns the decimals of the value, the numbers which can not be written as a decimal have unlimited decimals
+func decimalPlaces(i interface{}) (int, error) {
+	number, ok := utils.ToRat(i)
+	if !ok {
+		return 0, fmt.Errorf("%v is not a number", i)
+	}
+	places, exact := utils.DecimalPlaces(number)
+	if !exact {
+		return math.MaxInt, nil
+	}
+	return places, nil
+}
+
+func PrepareMaxDecimalPlaces(attr map[string]interface{}) error {
+	_, err := intAttribute(attr, "max")
+	return err
+}
+
+func MaxDecimalPlaces(i interface{}, attributes map[string]interface{}) error {
+	places, err := decimalPlaces(i)
+	if err != nil {
+		return err
+	}
+	max, err := intAttribute(attributes, "max")
+	if err != nil {
+		return err
+	}
+	if places > max {
+		return NewValidationError("{value} can not have more than {max} decimal places", map[string]interface{}{"value": i, "max": max})
+	}
+	return nil
+}
+
+func PrepareDecimalPrecision(attr map[string]interface{}) error {
+	precision, err := intAttribute(attr, "precision")
+	if err != nil {
+		return err
+	}
+	scale, err := intAttribute(attr, "scale")
+	if err != nil {
+		return err
+	}
+	if precision == 0 || scale > precision {
+		return errors.New("precision attribute should be greater than 0 and not lesser than scale")
+	}
+	return nil
+}
+
+// DecimalPrecision checks the value fits a decimal(precision, scale) column, it can have at most scale decimal places
+// and precision - scale digits before the decimal point
+func DecimalPrecision(i interface{}, attributes map[string]interface{}) error {
+	places, err := decimalPlaces(i)
+	if err != nil {
+		return err
+	}
+	precision, err := intAttribute(attributes, "precision")
+	if err != nil {
+		return err
+	}
+	scale, err := intAttribute(attributes, "scale")
+	if err != nil {
+		return err
+	}
+	number, _ := utils.ToRat(i)
+	integerPart := new(big.Int).Quo(number.Num(), number.Denom())
+	integerDigits := len(integerPart.Abs(integerPart).String())
+	if integerPart.Sign() == 0 {
+		integerDigits = 0
+	}
+	if places > scale || integerDigits > precision-scale {
+		return NewValidationError("{value} does not fit the precision {precision} with {scale} decimal places", map[string]interface{}{
+			"value":     i,
+			"precision": precision,
+			"scale":     scale,
+		})
+	}
+	return nil
+}
+
+func sign(i interface{}) (int, error) {
+	number, ok := utils.ToRat(i)
+	if !ok {
+		return 0, fmt.Errorf("%v is not a number", i)
+	}
+	return number.Sign(), nil
+}
+
+func IsPositive(i interface{}, _ map[string]interface{}) error {
+	s, err := sign(i)
+	if err != nil {
+		return err
+	}
+	if s <= 0 {
+		return NewValidationError("{value} should be positive", map[string]interface{}{"value": i})
+	}
+	return nil
+}
+
+func IsNegative(i interface{}, _ map[string]interface{}) error {
+	s, err := sign(i)
+	if err != nil {
+		return err
+	}
+	if s >= 0 {
+		return NewValidationError("{value} should be negative", map[string]interface{}{"value": i})
+	}
+	return nil
+}
+
+func IsNonNegative(i interface{}, _ map[string]interface{}) error {
+	s, err := sign(i)
+	if err != nil {
+		return err
+	}
+	if s < 0 {
+		return NewValidationError("{value} can not be negative", map[string]interface{}{"value": i})
+	}
+	return nil
+}
+
+func PreparePercentage(attr map[string]interface{}) error {
+	if fraction, exists := attr["fraction"]; exists {
+		if _, ok := fraction.(bool); !ok {
+			return errors.New("fraction attribute should be a boolean")
+		}
+	}
+	return nil
+}
+
+// IsPercentage checks the value is between 0 and 100, with the attribute 'fraction' it is between 0 and 1
+func IsPercentage(i interface{}, attributes map[string]interface{}) error {
+	number, ok := utils.ToRat(i)
+	if !ok {
+		return fmt.Errorf("%v is not a number", i)
+	}
+	max := big.NewRat(100, 1)
+	if fraction, _ := attributes["fraction"].(bool); fraction {
+		max = big.NewRat(1, 1)
+	}
+	if number.Sign() < 0 || number.Cmp(max) > 0 {
+		return NewValidationError("{value} should be between 0 and {max}", map[string]interface{}{"value": i, "max": json.Number(utils.FormatRat(max))})
+	}
+	return nil
+}
+
+// integerLimit returns the limit of IntegerInRange, without the attribute it is the limit of int64
+func integerLimit(attributes map[string]interface{}, name string, fallback int64) (*big.Rat, error) {
+	if _, exists := attributes[name]; !exists {
+		return new(big.Rat).SetInt64(fallback), nil
+	}
+	limit, err := ratAttribute(attributes, name)
+	if err != nil {
+		return nil, err
+	}
+	if !limit.IsInt() {
+		return nil, fmt.Errorf("%s attribute should be an integer", name)
+	}
+	return limit, nil
+}
+
+func PrepareIntegerInRange(attr map[string]interface{}) error {
+	min, err := integerLimit(attr, "min", math.MinInt64)
+	if err != nil {
+		return err
+	}
+	max, err := integerLimit(attr, "max", math.MaxInt64)
+	if err != nil {
+		return err
+	}
+	if min.Cmp(max) > 0 {
+		return errors.New("min attribute can not be greater than max attribute")
+	}
+	return nil
+}
+
+// IntegerInRange checks the value is an integer between min and max, which default to the limits of int64,
+// the values are compared exactly so it works for all 64 bit integers
+func IntegerInRange(i interface{}, attributes map[string]interface{}) error {
+	number, ok := utils.ToRat(i)
+	if !ok || !number.IsInt() {
+		return fmt.Errorf("%v is not an integer", i)
+	}
+	min, err := integerLimit(attributes, "min", math.MinInt64)
+	if err != nil {
+		return err
+	}
+	max, err := integerLimit(attributes, "max", math.MaxInt64)
+	if err != nil {
+		return err
+	}
+	if number.Cmp(min) < 0 || number.Cmp(max) > 0 {
+		return NewValidationError("{value} should be between {min} and {max}", map[string]interface{}{
+			"value": i,
+			"min":   json.Number(utils.FormatRat(min)),
+			"max":   json.Number(utils.FormatRat(max)),
+		})
+	}
+	return nil
+}
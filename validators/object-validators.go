@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"strings"
+)
+
+func RequiredKeys(i interface{}, attr map[string]interface{}) error {
+	object, ok := i.(map[string]interface{})
+	if !ok {
+		return errors.New("only objects are allowed")
+	}
+	keys, ok := attr["keys"].([]interface{})
+	if !ok {
+		return errors.New("attribute 'keys' must be a list of keys")
+	}
+	var missing []string
+	for _, key := range keys {
+		if _, exists := object[fmt.Sprint(key)]; !exists {
+			missing = append(missing, fmt.Sprint(key))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required keys %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func MinProperties(i interface{}, attr map[string]interface{}) error {
+	object, ok := i.(map[string]interface{})
+	if !ok {
+		return errors.New("only objects are allowed")
+	}
+	if minProperties, ok := utils.ToFloat64(attr["min"]); !ok || minProperties < 0 {
+		return errors.New("attribute 'min' must be a non-negative number")
+	} else if len(object) < int(minProperties) {
+		return fmt.Errorf("object can not have less than %d properties", int(minProperties))
+	}
+	return nil
+}
+
+func MaxProperties(i interface{}, attr map[string]interface{}) error {
+	object, ok := i.(map[string]interface{})
+	if !ok {
+		return errors.New("only objects are allowed")
+	}
+	if maxProperties, ok := utils.ToFloat64(attr["max"]); !ok || maxProperties < 0 {
+		return errors.New("attribute 'max' must be a non-negative number")
+	} else if len(object) > int(maxProperties) {
+		return fmt.Errorf("object can not have more than %d properties", int(maxProperties))
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package validators
+
+import (
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"github.com/DScale-io/jsonschematics/validators/archives"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+type passwordPolicy struct {
+	minLength    int
+	maxLength    int
+	minUpper     int
+	minLower     int
+	minDigits    int
+	minSpecial   int
+	minEntropy   float64
+	denylist     bool
+	denylistFile string
+}
+
+// passwordLists are the denylists loaded from the files by their path
+var passwordLists sync.Map
+
+func optionalIntAttribute(attr map[string]interface{}, name string, fallback int) (int, error) {
+	if _, exists := attr[name]; !exists {
+		return fallback, nil
+	}
+	return intAttribute(attr, name)
+}
+
+func passwordPolicyOf(attr map[string]interface{}) (*passwordPolicy, error) {
+	policy := passwordPolicy{denylist: true}
+	var err error
+	for name, target := range map[string]*int{
+		"min_uppercase": &policy.minUpper,
+		"min_lowercase": &policy.minLower,
+		"min_digits":    &policy.minDigits,
+		"min_special":   &policy.minSpecial,
+		"max_length":    &policy.maxLength,
+	} {
+		if *target, err = optionalIntAttribute(attr, name, 0); err != nil {
+			return nil, err
+		}
+	}
+	if policy.minLength, err = optionalIntAttribute(attr, "min_length", 8); err != nil {
+		return nil, err
+	}
+	if policy.maxLength > 0 && policy.maxLength < policy.minLength {
+		return nil, fmt.Errorf("max_length attribute should not be lesser than min_length")
+	}
+	if entropy, exists := attr["min_entropy"]; exists {
+		var ok bool
+		if policy.minEntropy, ok = utils.ToFloat64(entropy); !ok || policy.minEntropy < 0 {
+			return nil, fmt.Errorf("min_entropy attribute should be a non-negative number")
+		}
+	}
+	if denylist, exists := attr["denylist"]; exists {
+		var ok bool
+		if policy.denylist, ok = denylist.(bool); !ok {
+			return nil, fmt.Errorf("denylist attribute should be a boolean")
+		}
+	}
+	if file, exists := attr["denylist_file"]; exists {
+		var ok bool
+		if policy.denylistFile, ok = file.(string); !ok {
+			return nil, fmt.Errorf("denylist_file attribute should be a path")
+		}
+	}
+	return &policy, nil
+}
+
+func loadPasswordList(path string) (map[string]bool, error) {
+	if list, exists := passwordLists.Load(path); exists {
+		return list.(map[string]bool), nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the denylist: %v", err)
+	}
+	defer file.Close()
+	list, err := archives.ReadPasswordList(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the denylist: %v", err)
+	}
+	passwordLists.Store(path, list)
+	return list, nil
+}
+
+func PreparePasswordPolicy(attr map[string]interface{}) error {
+	policy, err := passwordPolicyOf(attr)
+	if err != nil {
+		return err
+	}
+	if policy.denylistFile != "" {
+		_, err = loadPasswordList(policy.denylistFile)
+	}
+	return err
+}
+
+// passwordEntropy estimates the bits of the password from its length and the size of the character classes it uses
+func passwordEntropy(password string) float64 {
+	sizes := make(map[string]int)
+	for _, r := range password {
+		switch {
+		case r >= 'a' && r <= 'z':
+			sizes["lower"] = 26
+		case r >= 'A' && r <= 'Z':
+			sizes["upper"] = 26
+		case r >= '0' && r <= '9':
+			sizes["digit"] = 10
+		case r < unicode.MaxASCII:
+			sizes["special"] = 33
+		default:
+			sizes["other"] = 100
+		}
+	}
+	pool := 0
+	for _, size := range sizes {
+		pool += size
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(len([]rune(password))) * math.Log2(float64(pool))
+}
+
+// PasswordPolicy checks all the rules of the password together and reports all the rules which are not met,
+// 'min_length' is 8 by default, 'min_uppercase', 'min_lowercase', 'min_digits' and 'min_special' are counts,
+// 'min_entropy' is in bits, the common passwords are rejected unless 'denylist' is false and 'denylist_file'
+// adds a list, 'not_contain' is a list of values (e.g. references to the username and the email) which can
+// not be in the password
+func PasswordPolicy(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	password := i.(string)
+	policy, err := passwordPolicyOf(attr)
+	if err != nil {
+		return err
+	}
+
+	var upper, lower, digits, special int
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsDigit(r):
+			digits++
+		case !unicode.IsLetter(r) && !unicode.IsMark(r):
+			special++
+		}
+	}
+
+	var rules []string
+	length := len([]rune(password))
+	if length < policy.minLength {
+		rules = append(rules, fmt.Sprintf("at least %d characters are required", policy.minLength))
+	}
+	if policy.maxLength > 0 && length > policy.maxLength {
+		rules = append(rules, fmt.Sprintf("at most %d characters are allowed", policy.maxLength))
+	}
+	for _, class := range []struct {
+		count int
+		min   int
+		name  string
+	}{
+		{upper, policy.minUpper, "uppercase letter"},
+		{lower, policy.minLower, "lowercase letter"},
+		{digits, policy.minDigits, "digit"},
+		{special, policy.minSpecial, "special character"},
+	} {
+		if class.count < class.min {
+			rules = append(rules, fmt.Sprintf("at least %d %s(s) required", class.min, class.name))
+		}
+	}
+	if policy.minEntropy > 0 && passwordEntropy(password) < policy.minEntropy {
+		rules = append(rules, "password is too easy to guess")
+	}
+	if policy.denylist && archives.IsCommonPassword(password) {
+		rules = append(rules, "password is too common")
+	}
+	if policy.denylistFile != "" {
+		list, err := loadPasswordList(policy.denylistFile)
+		if err != nil {
+			return err
+		}
+		if list[strings.ToLower(password)] {
+			rules = append(rules, "password is not allowed")
+		}
+	}
+	if values, _ := attr["not_contain"].([]interface{}); passwordContainsAny(password, values) {
+		rules = append(rules, "password can not contain the personal information")
+	}
+
+	if len(rules) > 0 {
+		return NewValidationError("password does not meet the policy: {rules}", map[string]interface{}{"rules": strings.Join(rules, ", ")})
+	}
+	return nil
+}
+
+// passwordContainsAny checks the values of 3 or more characters in the password ignoring the case,
+// for the emails the name before @ is checked as well
+func passwordContainsAny(password string, values []interface{}) bool {
+	password = strings.ToLower(password)
+	for _, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		str = strings.ToLower(strings.TrimSpace(str))
+		candidates := []string{str}
+		if at := strings.Index(str, "@"); at > 0 {
+			candidates = append(candidates, str[:at])
+		}
+		for _, candidate := range candidates {
+			if len([]rune(candidate)) >= 3 && strings.Contains(password, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
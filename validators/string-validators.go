@@ -3,9 +3,11 @@ package validators
 import (
 	"errors"
 	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
 	"net/url"
 	"regexp"
 	"strings"
+	"unicode"
 )
 
 func IsString(i interface{}, _ map[string]interface{}) error {
@@ -81,21 +83,44 @@ func IsEmail(i interface{}, attr map[string]interface{}) error {
 	return nil
 }
 
+// lengthOf counts the string with the attribute 'mode' which can be bytes, runes (default) or graphemes
+func lengthOf(str string, attr map[string]interface{}) (int, error) {
+	mode, _ := attr["mode"].(string)
+	return utils.StringLength(str, mode)
+}
+
+func PrepareLengthMode(attr map[string]interface{}) error {
+	mode, exists := attr["mode"]
+	if !exists {
+		return nil
+	}
+	name, ok := mode.(string)
+	if !ok {
+		return errors.New("mode attribute should be a string")
+	}
+	_, err := utils.StringLength("", name)
+	return err
+}
+
 func MaxLengthAllowed(i interface{}, attr map[string]interface{}) error {
 	isString := IsString(i, attr)
 	if isString != nil {
 		return isString
 	}
 	str := i.(string)
-	if _, ok := attr["max"].(float64); !ok {
+	if _, ok := utils.ToFloat64(attr["max"]); !ok {
 		return errors.New("max is required and should be number in the validator's attributes")
 	}
-	length, ok := attr["max"].(float64)
+	length, ok := utils.ToFloat64(attr["max"])
 	intLength := int(length)
 	if !ok {
 		return errors.New("max is not provided as an int in attributes of schema")
 	}
-	if len(str) > intLength {
+	size, err := lengthOf(str, attr)
+	if err != nil {
+		return err
+	}
+	if size > intLength {
 		return errors.New(fmt.Sprintf("length of the string is greater than %d", intLength))
 	}
 	return nil
@@ -107,15 +132,19 @@ func MinLengthAllowed(i interface{}, attr map[string]interface{}) error {
 		return isString
 	}
 	str := i.(string)
-	if _, ok := attr["min"].(float64); !ok {
+	if _, ok := utils.ToFloat64(attr["min"]); !ok {
 		return errors.New("min is required and should be number in the validator's attributes")
 	}
-	length, ok := attr["min"].(float64)
+	length, ok := utils.ToFloat64(attr["min"])
 	intLength := int(length)
 	if !ok {
 		return errors.New("min is not provided as an int in attributes of schema")
 	}
-	if len(str) < intLength {
+	size, err := lengthOf(str, attr)
+	if err != nil {
+		return err
+	}
+	if size < intLength {
 		return errors.New(fmt.Sprintf("length of the string is less than %d", intLength))
 	}
 	return nil
@@ -127,33 +156,39 @@ func InBetweenLengthAllowed(i interface{}, attr map[string]interface{}) error {
 		return isString
 	}
 	str := i.(string)
-	if _, ok := attr["min"].(float64); !ok {
+	if _, ok := utils.ToFloat64(attr["min"]); !ok {
 		return errors.New("min is required and should be number in the validator's attributes")
 	}
-	minlength := attr["min"].(float64)
-	if _, ok := attr["max"].(float64); !ok {
+	minlength, _ := utils.ToFloat64(attr["min"])
+	if _, ok := utils.ToFloat64(attr["max"]); !ok {
 		return errors.New("max is required and should be number in the validator's attributes")
 	}
-	maxlength := attr["max"].(float64)
+	maxlength, _ := utils.ToFloat64(attr["max"])
 
 	intMinLength := int(minlength)
 	intMaxLength := int(maxlength)
-	if len(str) < intMinLength || len(str) > intMaxLength {
+	size, err := lengthOf(str, attr)
+	if err != nil {
+		return err
+	}
+	if size < intMinLength || size > intMaxLength {
 		return errors.New(fmt.Sprintf("length of the string should be greater than %d and less than %d", intMinLength, intMaxLength))
 	}
 	return nil
 }
 
+// NoSpecialCharacters allows the letters and the digits of any language, the characters in the attribute 'allow' e.g. " -" are also allowed
 func NoSpecialCharacters(i interface{}, attr map[string]interface{}) error {
 	isString := IsString(i, attr)
 	if isString != nil {
 		return isString
 	}
 	str := i.(string)
-	pattern := `[^a-zA-Z0-9]`
-	re := regexp.MustCompile(pattern)
-	if !re.MatchString(str) {
-		return errors.New("special Characters are not allowed")
+	allowed, _ := attr["allow"].(string)
+	for _, r := range str {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) && !strings.ContainsRune(allowed, r) {
+			return errors.New("special Characters are not allowed")
+		}
 	}
 	return nil
 }
@@ -163,7 +198,7 @@ func HaveSpecialCharacters(i interface{}, attr map[string]interface{}) error {
 	if isString != nil {
 		return isString
 	}
-	err := NoSpecialCharacters(i, nil)
+	err := NoSpecialCharacters(i, attr)
 	if err == nil {
 		return errors.New("special characters are required")
 	}
@@ -349,3 +384,109 @@ func MatchStrings(i interface{}, attr map[string]interface{}) error {
 	}
 	return nil
 }
+
+// scriptOf returns the script of the letter, the digits, the punctuation and the marks are in the Common or Inherited scripts
+func scriptOf(r rune) string {
+	for name, table := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" && unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
+
+func scriptsOf(attr map[string]interface{}) (map[string]bool, error) {
+	list, ok := attr["scripts"].([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, errors.New("scripts attribute is required as a list e.g. [\"Latin\", \"Arabic\"]")
+	}
+	scripts := make(map[string]bool)
+	for _, s := range list {
+		name, _ := s.(string)
+		if _, exists := unicode.Scripts[name]; !exists {
+			return nil, fmt.Errorf("%v is not a unicode script", s)
+		}
+		scripts[name] = true
+	}
+	return scripts, nil
+}
+
+func PrepareScripts(attr map[string]interface{}) error {
+	if _, err := scriptsOf(attr); err != nil {
+		return err
+	}
+	if mixed, exists := attr["mixed"]; exists {
+		if _, ok := mixed.(bool); !ok {
+			return errors.New("mixed attribute should be a boolean")
+		}
+	}
+	return nil
+}
+
+// AllowedScripts checks the letters are written in the 'scripts' e.g. ["Latin", "Arabic"],
+// with 'mixed' false all the letters have to be in one of the scripts
+func AllowedScripts(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	scripts, err := scriptsOf(attr)
+	if err != nil {
+		return err
+	}
+	mixed, exists := attr["mixed"].(bool)
+	if !exists {
+		mixed = true
+	}
+	used := ""
+	for _, r := range i.(string) {
+		script := scriptOf(r)
+		if script == "" {
+			continue
+		}
+		if !scripts[script] {
+			return NewValidationError("{script} characters are not allowed", map[string]interface{}{"script": script})
+		}
+		if !mixed && used != "" && used != script {
+			return NewValidationError("{first} and {second} characters can not be mixed", map[string]interface{}{"first": used, "second": script})
+		}
+		used = script
+	}
+	return nil
+}
+
+// NoControlCharacters rejects the control and the invisible formatting characters like the bidi overrides,
+// the joiners which are needed by the arabic and the emoji are allowed, new lines and tabs with 'multiline' true
+func NoControlCharacters(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	multiline, _ := attr["multiline"].(bool)
+	for _, r := range i.(string) {
+		if multiline && (r == '\n' || r == '\r' || r == '\t') {
+			continue
+		}
+		if r == '\u200C' || r == '\u200D' {
+			continue
+		}
+		if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+			return NewValidationError("control character {character} is not allowed", map[string]interface{}{"character": fmt.Sprintf("%U", r)})
+		}
+	}
+	return nil
+}
+
+// IsPrintable checks every character is visible or a space
+func IsPrintable(i interface{}, attr map[string]interface{}) error {
+	isString := IsString(i, attr)
+	if isString != nil {
+		return isString
+	}
+	for _, r := range i.(string) {
+		if !unicode.IsGraphic(r) && r != '\u200C' && r != '\u200D' {
+			return NewValidationError("character {character} is not printable", map[string]interface{}{"character": fmt.Sprintf("%U", r)})
+		}
+	}
+	return nil
+}
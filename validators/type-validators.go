@@ -0,0 +1,312 @@
+package validators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/DScale-io/jsonschematics/utils"
+	"math/big"
+	"net"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TypeString   = "string"
+	TypeInteger  = "integer"
+	TypeNumber   = "number"
+	TypeBoolean  = "boolean"
+	TypeDate     = "date"
+	TypeDateTime = "datetime"
+	TypeArray    = "array"
+	TypeObject   = "object"
+	TypeNull     = "null"
+)
+
+const (
+	DateLayout     = "2006-01-02"
+	DateTimeLayout = time.RFC3339
+)
+
+var typeCheckers = map[string]Validator{
+	TypeString:   IsString,
+	TypeInteger:  isIntegerType,
+	TypeNumber:   isNumberType,
+	TypeBoolean:  IsBoolean,
+	TypeDate:     isDateType,
+	TypeDateTime: isDateTimeType,
+	TypeArray:    IsArray,
+	TypeObject:   IsObject,
+	TypeNull:     IsNull,
+}
+
+var hostnameRegex = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+var formatCheckers = map[string]Validator{
+	"email": IsEmail,
+	"uuid":  IsValidUuid,
+	"url":   IsURL,
+	"ipv4": func(i interface{}, _ map[string]interface{}) error {
+		ip := net.ParseIP(fmt.Sprint(i))
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%v is not a valid ipv4 address", i)
+		}
+		return nil
+	},
+	"ipv6": func(i interface{}, _ map[string]interface{}) error {
+		ip := net.ParseIP(fmt.Sprint(i))
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%v is not a valid ipv6 address", i)
+		}
+		return nil
+	},
+	"hostname": func(i interface{}, _ map[string]interface{}) error {
+		if str, ok := i.(string); !ok || len(str) > 253 || !hostnameRegex.MatchString(str) {
+			return fmt.Errorf("%v is not a valid hostname", i)
+		}
+		return nil
+	},
+}
+
+func IsKnownType(typ string) bool {
+	_, exists := typeCheckers[typ]
+	return exists
+}
+
+func IsKnownFormat(format string) bool {
+	_, exists := formatCheckers[format]
+	return exists
+}
+
+// CheckType returns a single error describing the mismatch when the value is not of the type
+func CheckType(typ string, i interface{}) error {
+	checker, exists := typeCheckers[typ]
+	if !exists {
+		return fmt.Errorf("unknown type %s", typ)
+	}
+	if checker(i, nil) != nil {
+		return fmt.Errorf("expected %s but got %s", typ, TypeOf(i))
+	}
+	return nil
+}
+
+func CheckFormat(format string, i interface{}) error {
+	checker, exists := formatCheckers[format]
+	if !exists {
+		return fmt.Errorf("unknown format %s", format)
+	}
+	if _, ok := i.(string); !ok {
+		return fmt.Errorf("format %s can only be checked on strings", format)
+	}
+	return checker(i, nil)
+}
+
+// TypeOf names the json type of the value
+func TypeOf(i interface{}) string {
+	if i == nil {
+		return TypeNull
+	}
+	switch i.(type) {
+	case string:
+		return TypeString
+	case bool:
+		return TypeBoolean
+	case map[string]interface{}:
+		return TypeObject
+	}
+	if number, ok := utils.ToRat(i); ok {
+		if number.IsInt() {
+			return TypeInteger
+		}
+		return TypeNumber
+	}
+	if isArray(i) {
+		return TypeArray
+	}
+	return reflect.TypeOf(i).String()
+}
+
+func IsBoolean(i interface{}, _ map[string]interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return errors.New("is not a boolean")
+	}
+	return nil
+}
+
+func IsArray(i interface{}, _ map[string]interface{}) error {
+	if i == nil || !isArray(i) {
+		return errors.New("is not an array")
+	}
+	return nil
+}
+
+func IsObject(i interface{}, _ map[string]interface{}) error {
+	if _, ok := i.(map[string]interface{}); !ok {
+		return errors.New("is not an object")
+	}
+	return nil
+}
+
+func IsNull(i interface{}, _ map[string]interface{}) error {
+	if i != nil {
+		return errors.New("is not null")
+	}
+	return nil
+}
+
+func isIntegerType(i interface{}, _ map[string]interface{}) error {
+	if !utils.IsWholeNumber(i) {
+		return errors.New("value is not an integer")
+	}
+	return nil
+}
+
+func isNumberType(i interface{}, _ map[string]interface{}) error {
+	if _, ok := utils.ToRat(i); !ok {
+		return errors.New("value is not a number")
+	}
+	return nil
+}
+
+func isDateType(i interface{}, _ map[string]interface{}) error {
+	str, ok := i.(string)
+	if !ok {
+		return errors.New("is not a date")
+	}
+	if _, err := time.Parse(DateLayout, str); err != nil {
+		return errors.New("is not a date")
+	}
+	return nil
+}
+
+func isDateTimeType(i interface{}, _ map[string]interface{}) error {
+	str, ok := i.(string)
+	if !ok {
+		return errors.New("is not a datetime")
+	}
+	if _, err := time.Parse(DateTimeLayout, str); err != nil {
+		return errors.New("is not a datetime")
+	}
+	return nil
+}
+
+var booleanStrings = map[string]bool{
+	"true":  true,
+	"1":     true,
+	"yes":   true,
+	"on":    true,
+	"false": false,
+	"0":     false,
+	"no":    false,
+	"off":   false,
+}
+
+var coercibleDateLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	DateLayout,
+}
+
+// Coerce converts the value to the type, strings from forms and query strings like "42", "true" or "2024-01-01"
+// are converted to their typed values, integers and numbers are returned as json.Number so they stay exact
+func Coerce(typ string, i interface{}) (interface{}, error) {
+	if CheckType(typ, i) == nil && typ != TypeInteger && typ != TypeDate && typ != TypeDateTime {
+		return i, nil
+	}
+	str, isString := i.(string)
+	str = strings.TrimSpace(str)
+	switch typ {
+	case TypeString:
+		switch v := i.(type) {
+		case bool:
+			return strconv.FormatBool(v), nil
+		case nil:
+			return nil, errors.New("can not convert null to string")
+		}
+		if number, ok := utils.ToRat(i); ok {
+			return utils.FormatRat(number), nil
+		}
+	case TypeInteger:
+		if isString {
+			if number, ok := numberString(str); ok && number.IsInt() {
+				return integerNumber(i, number)
+			}
+		} else if number, ok := utils.ToRat(i); ok && number.IsInt() {
+			switch i.(type) {
+			case float32, float64:
+				return integerNumber(i, number)
+			case json.Number:
+				return json.Number(number.Num().String()), nil
+			}
+			return i, nil
+		}
+	case TypeNumber:
+		if isString {
+			if _, ok := numberString(str); ok {
+				return json.Number(strings.TrimPrefix(str, "+")), nil
+			}
+		}
+	case TypeBoolean:
+		if isString {
+			if b, exists := booleanStrings[strings.ToLower(str)]; exists {
+				return b, nil
+			}
+		} else if number := convertToFloat64(i); number != nil && (*number == 0 || *number == 1) {
+			return *number == 1, nil
+		}
+	case TypeDate, TypeDateTime:
+		if isString {
+			for _, layout := range coercibleDateLayouts {
+				if date, err := time.Parse(layout, str); err == nil {
+					if typ == TypeDate {
+						return date.Format(DateLayout), nil
+					}
+					return date.Format(DateTimeLayout), nil
+				}
+			}
+		}
+	case TypeArray, TypeObject:
+		if isString {
+			var decoded interface{}
+			if err := utils.DecodeJSON([]byte(str), &decoded); err == nil && CheckType(typ, decoded) == nil {
+				return decoded, nil
+			}
+		}
+	case TypeNull:
+		if isString && (str == "" || strings.ToLower(str) == "null") {
+			return nil, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown type %s", typ)
+	}
+	return nil, fmt.Errorf("can not convert %v to %s", i, typ)
+}
+
+// numberString reads a number written in a string with the json syntax, a leading + is allowed
+func numberString(str string) (*big.Rat, bool) {
+	if strings.HasPrefix(str, "+-") {
+		return nil, false
+	}
+	var decoded interface{}
+	if err := utils.DecodeJSON([]byte(strings.TrimPrefix(str, "+")), &decoded); err != nil {
+		return nil, false
+	}
+	number, ok := decoded.(json.Number)
+	if !ok {
+		return nil, false
+	}
+	return utils.ToRat(number)
+}
+
+// integerNumber writes the converted whole number as a json.Number, it has to fit an int64
+func integerNumber(i interface{}, number *big.Rat) (interface{}, error) {
+	if !number.Num().IsInt64() {
+		return nil, fmt.Errorf("%v is out of the range of a 64 bit integer", i)
+	}
+	return json.Number(number.Num().String()), nil
+}
@@ -4,11 +4,24 @@ import "github.com/DScale-io/jsonschematics/utils"
 
 type Validators struct {
 	ValidationFns map[string]Validator
-	Logger        utils.Logger
+	AggregateFns  map[string]AggregateValidator
+	Preparers     map[string]Preparer
+	// AggregatePreparers check the attributes of the aggregate validators when the schema is loaded
+	AggregatePreparers map[string]Preparer
+	Lookups            map[string]LookupProvider
+	// Network makes the requests of the network validators, DefaultNetworkChecker is used without it
+	Network *NetworkChecker
+	// NullAware are the validators which are also called for null values of nullable fields
+	NullAware map[string]bool
+	Logger    utils.Logger
 }
 
 type Validator func(interface{}, map[string]interface{}) error
 
+// Preparer runs once for every usage of the validator when the schema is loaded,
+// it can check the attributes and keep the pre-processed values inside them
+type Preparer func(map[string]interface{}) error
+
 func (v *Validators) RegisterValidator(name string, fn Validator) {
 	v.Logger.DEBUG("registering validator:", name)
 	if v.ValidationFns == nil {
@@ -17,6 +30,47 @@ func (v *Validators) RegisterValidator(name string, fn Validator) {
 	v.ValidationFns[name] = fn
 }
 
+func (v *Validators) RegisterAggregateValidator(name string, fn AggregateValidator) {
+	v.Logger.DEBUG("registering aggregate validator:", name)
+	if v.AggregateFns == nil {
+		v.AggregateFns = make(map[string]AggregateValidator)
+	}
+	v.AggregateFns[name] = fn
+}
+
+func (v *Validators) RegisterAggregatePreparer(name string, fn Preparer) {
+	v.Logger.DEBUG("registering aggregate preparer:", name)
+	if v.AggregatePreparers == nil {
+		v.AggregatePreparers = make(map[string]Preparer)
+	}
+	v.AggregatePreparers[name] = fn
+}
+
+func (v *Validators) RegisterPreparer(name string, fn Preparer) {
+	v.Logger.DEBUG("registering preparer:", name)
+	if v.Preparers == nil {
+		v.Preparers = make(map[string]Preparer)
+	}
+	v.Preparers[name] = fn
+}
+
+// RegisterNullAwareValidator registers a validator which handles null values itself,
+// the other validators are skipped when a nullable field is null
+func (v *Validators) RegisterNullAwareValidator(name string, fn Validator) {
+	v.RegisterValidator(name, fn)
+	if v.NullAware == nil {
+		v.NullAware = make(map[string]bool)
+	}
+	v.NullAware[name] = true
+}
+
+func (v *Validators) StatusCodeCheck(i interface{}, attr map[string]interface{}) error {
+	if v.Network == nil {
+		return StatusCodeCheck(i, attr)
+	}
+	return v.Network.StatusCodeCheck(i, attr)
+}
+
 func (v *Validators) BasicValidators() {
 	v.Logger.DEBUG("loading all the basic validators")
 	// String Validators
@@ -24,10 +78,19 @@ func (v *Validators) BasicValidators() {
 	v.RegisterValidator("NotEmpty", NotEmpty)
 	v.RegisterValidator("IsEmail", IsEmail)
 	v.RegisterValidator("MaxLengthAllowed", MaxLengthAllowed)
+	v.RegisterPreparer("MaxLengthAllowed", PrepareLengthMode)
 	v.RegisterValidator("MinLengthAllowed", MinLengthAllowed)
+	v.RegisterPreparer("MinLengthAllowed", PrepareLengthMode)
 	v.RegisterValidator("InBetweenLengthAllowed", InBetweenLengthAllowed)
+	v.RegisterPreparer("InBetweenLengthAllowed", PrepareLengthMode)
 	v.RegisterValidator("NoSpecialCharacters", NoSpecialCharacters)
 	v.RegisterValidator("HaveSpecialCharacters", HaveSpecialCharacters)
+	v.RegisterValidator("PasswordPolicy", PasswordPolicy)
+	v.RegisterPreparer("PasswordPolicy", PreparePasswordPolicy)
+	v.RegisterValidator("AllowedScripts", AllowedScripts)
+	v.RegisterPreparer("AllowedScripts", PrepareScripts)
+	v.RegisterValidator("NoControlCharacters", NoControlCharacters)
+	v.RegisterValidator("IsPrintable", IsPrintable)
 	v.RegisterValidator("LeastOneUpperCase", LeastOneUpperCase)
 	v.RegisterValidator("LeastOneLowerCase", LeastOneLowerCase)
 	v.RegisterValidator("LeastOneDigit", LeastOneDigit)
@@ -36,10 +99,16 @@ func (v *Validators) BasicValidators() {
 	v.RegisterValidator("HaveURLHostName", HaveURLHostName)
 	v.RegisterValidator("HaveQueryParameter", HaveQueryParameter)
 	v.RegisterValidator("IsHttps", IsHttps)
-	v.RegisterValidator("IsURL", IsValidUuid)
+	v.RegisterValidator("IsValidUuid", IsValidUuid)
 	v.RegisterValidator("LIKE", LIKE)
 	v.RegisterValidator("MatchRegex", MatchRegex)
 
+	// Type Validators
+	v.RegisterValidator("IsBoolean", IsBoolean)
+	v.RegisterValidator("IsArray", IsArray)
+	v.RegisterValidator("IsObject", IsObject)
+	v.RegisterNullAwareValidator("IsNull", IsNull)
+
 	// Number Validators
 	v.RegisterValidator("IsNumber", IsNumber)
 	v.RegisterValidator("IsInteger", IsInteger)
@@ -49,26 +118,115 @@ func (v *Validators) BasicValidators() {
 	v.RegisterValidator("InBetween", InBetween)
 	v.RegisterValidator("IsGreaterThanZero", IsGreaterThanZero)
 	v.RegisterValidator("IsLesserThanZero", IsLesserThanZero)
+	v.RegisterValidator("ExclusiveMinAllowed", ExclusiveMinAllowed)
+	v.RegisterPreparer("ExclusiveMinAllowed", PrepareRange("min"))
+	v.RegisterValidator("ExclusiveMaxAllowed", ExclusiveMaxAllowed)
+	v.RegisterPreparer("ExclusiveMaxAllowed", PrepareRange("max"))
+	v.RegisterValidator("ExclusiveInBetween", ExclusiveInBetween)
+	v.RegisterPreparer("ExclusiveInBetween", PrepareRange("min", "max"))
+	v.RegisterValidator("MultipleOf", MultipleOf)
+	v.RegisterPreparer("MultipleOf", PrepareMultipleOf)
+	v.RegisterValidator("MaxDecimalPlaces", MaxDecimalPlaces)
+	v.RegisterPreparer("MaxDecimalPlaces", PrepareMaxDecimalPlaces)
+	v.RegisterValidator("DecimalPrecision", DecimalPrecision)
+	v.RegisterPreparer("DecimalPrecision", PrepareDecimalPrecision)
+	v.RegisterValidator("IsPositive", IsPositive)
+	v.RegisterValidator("IsNegative", IsNegative)
+	v.RegisterValidator("IsNonNegative", IsNonNegative)
+	v.RegisterValidator("IsPercentage", IsPercentage)
+	v.RegisterPreparer("IsPercentage", PreparePercentage)
+	v.RegisterValidator("IntegerInRange", IntegerInRange)
+	v.RegisterPreparer("IntegerInRange", PrepareIntegerInRange)
 
 	// Date Validators
 	v.RegisterValidator("IsValidDate", IsValidDate)
+	v.RegisterPreparer("IsValidDate", PrepareDate())
 	v.RegisterValidator("IsLessThanNow", IsLessThanNow)
+	v.RegisterPreparer("IsLessThanNow", PrepareDate())
 	v.RegisterValidator("IsMoreThanNow", IsMoreThanNow)
+	v.RegisterPreparer("IsMoreThanNow", PrepareDate())
 	v.RegisterValidator("IsBefore", IsBefore)
+	v.RegisterPreparer("IsBefore", PrepareDate("maxTime"))
 	v.RegisterValidator("IsAfter", IsAfter)
+	v.RegisterPreparer("IsAfter", PrepareIsAfter)
 	v.RegisterValidator("IsInBetweenTime", IsInBetweenTime)
+	v.RegisterPreparer("IsInBetweenTime", PrepareDate("minTime", "maxTime"))
+	v.RegisterValidator("IsDayOfWeek", IsDayOfWeek)
+	v.RegisterPreparer("IsDayOfWeek", PrepareDays("days"))
+	v.RegisterValidator("IsWeekday", IsWeekday)
+	v.RegisterPreparer("IsWeekday", PrepareDays("weekend"))
+	v.RegisterValidator("IsBusinessDay", IsBusinessDay)
+	v.RegisterPreparer("IsBusinessDay", PrepareDays("weekend"))
+	v.RegisterValidator("IsTimeOfDayBetween", IsTimeOfDayBetween)
+	v.RegisterPreparer("IsTimeOfDayBetween", PrepareTimeOfDay)
 
 	//Arrays
 	v.RegisterValidator("ArrayLengthMax", ArrayLengthMax)
 	v.RegisterValidator("ArrayLengthMin", ArrayLengthMin)
 	v.RegisterValidator("StringsExistsInOptions", StringsExistsInOptions)
 	v.RegisterValidator("StringInOptions", StringInOptions)
+	v.RegisterValidator("InLookup", v.InLookup)
+	v.RegisterPreparer("InLookup", PrepareLookup)
+	v.RegisterValidator("NotInLookup", v.NotInLookup)
+	v.RegisterPreparer("NotInLookup", PrepareLookup)
+	v.RegisterValidator("ArrayNotEmpty", ArrayNotEmpty)
+	v.RegisterValidator("UniqueItems", UniqueItems)
+
+	// Objects
+	v.RegisterValidator("RequiredKeys", RequiredKeys)
+	v.RegisterValidator("MinProperties", MinProperties)
+	v.RegisterValidator("MaxProperties", MaxProperties)
+
+	// Comparisons
+	v.RegisterValidator("IsEqualTo", IsEqualTo)
+	v.RegisterValidator("IsNotEqualTo", IsNotEqualTo)
+	v.RegisterValidator("IsGreaterThan", IsGreaterThan)
+	v.RegisterValidator("IsGreaterThanOrEqualTo", IsGreaterThanOrEqualTo)
+	v.RegisterValidator("IsLessThan", IsLessThan)
+	v.RegisterValidator("IsLessThanOrEqualTo", IsLessThanOrEqualTo)
+	v.RegisterValidator("IsDateBefore", IsDateBefore)
+	v.RegisterValidator("IsDateAfter", IsDateAfter)
+
+	// Aggregates
+	v.RegisterAggregateValidator("Sum", Sum)
+	v.RegisterAggregatePreparer("Sum", PrepareAggregate)
+	v.RegisterAggregateValidator("Count", Count)
+	v.RegisterAggregatePreparer("Count", PrepareAggregate)
+	v.RegisterAggregateValidator("Min", Min)
+	v.RegisterAggregatePreparer("Min", PrepareAggregate)
+	v.RegisterAggregateValidator("Max", Max)
+	v.RegisterAggregatePreparer("Max", PrepareAggregate)
+	v.RegisterAggregateValidator("Avg", Avg)
+	v.RegisterAggregatePreparer("Avg", PrepareAggregate)
+	v.RegisterAggregateValidator("Any", Any)
+	v.RegisterAggregatePreparer("Any", PrepareMatching)
+	v.RegisterAggregateValidator("All", All)
+	v.RegisterAggregatePreparer("All", PrepareMatching)
+	v.RegisterAggregateValidator("None", None)
+	v.RegisterAggregatePreparer("None", PrepareMatching)
+
+	// Expressions
+	v.RegisterNullAwareValidator("Expr", Expr)
+	v.RegisterPreparer("Expr", PrepareExpr)
 
 	//url
-	v.RegisterValidator("StatusCodeCheck", StatusCodeCheck)
+	v.RegisterValidator("StatusCodeCheck", v.StatusCodeCheck)
 
 	//locales
 	v.RegisterValidator("IsCountryValid", IsCountryValid)
+	v.RegisterPreparer("IsCountryValid", PrepareCodeFormat("alpha2", "alpha3", "numeric", "name"))
+	v.RegisterValidator("IsCurrencyCode", IsCurrencyCode)
+	v.RegisterPreparer("IsCurrencyCode", PrepareCodeFormat("alpha", "numeric"))
+	v.RegisterValidator("IsCurrencyAmount", IsCurrencyAmount)
+	v.RegisterPreparer("IsCurrencyAmount", PrepareCurrencyAmount)
+	v.RegisterValidator("IsLanguageCode", IsLanguageCode)
+	v.RegisterPreparer("IsLanguageCode", PrepareCodeFormat("alpha2", "alpha3"))
+	v.RegisterValidator("IsLanguageTag", IsLanguageTag)
+	v.RegisterValidator("IsTimezone", IsTimezone)
+	v.RegisterValidator("IsPostalCode", IsPostalCode)
+	v.RegisterPreparer("IsPostalCode", PrepareCountry(true))
+	v.RegisterValidator("IsPhoneNumber", IsPhoneNumber)
+	v.RegisterPreparer("IsPhoneNumber", PrepareCountry(false))
 
 	v.Logger.DEBUG("basic validators loaded")
 }